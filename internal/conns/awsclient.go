@@ -27,22 +27,23 @@ import (
 )
 
 type AWSClient struct {
-	accountID                 string
-	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
-	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	ignoreTagsConfig          *tftags.IgnoreConfig
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	servicePackages           map[string]ServicePackage
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
-	terraformVersion          string // From provider configuration.
+	accountID                     string
+	awsConfig                     *aws.Config
+	clients                       map[string]map[string]any // Region -> service package name -> API client.
+	defaultTagsConfig             *tftags.DefaultConfig
+	endpoints                     map[string]string // From provider configuration.
+	httpClient                    *http.Client
+	ignoreTagsConfig              *tftags.IgnoreConfig
+	lock                          sync.Mutex
+	logger                        baselogging.Logger
+	partition                     endpoints.Partition
+	servicePackages               map[string]ServicePackage
+	s3ExpressClient               *s3.Client
+	s3UsePathStyle                bool            // From provider configuration.
+	s3USEast1RegionalEndpoint     string          // From provider configuration.
+	stsRegion                     string          // From provider configuration.
+	terraformVersion              string          // From provider configuration.
+	useDualStackEndpointOverrides map[string]bool // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -338,6 +339,12 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if v, ok := c.useDualStackEndpointOverrides[servicePackageName]; ok {
+		// The AWS SDK uses the first dual-stack endpoint setting found in the configuration sources.
+		cfg := c.awsConfig.Copy()
+		cfg.ConfigSources = append([]any{useDualStackEndpointOverride(v)}, cfg.ConfigSources...)
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -354,6 +361,63 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// useDualStackEndpointOverride is an AWS SDK configuration source that overrides the dual-stack endpoint setting.
+type useDualStackEndpointOverride bool
+
+func (v useDualStackEndpointOverride) GetUseDualStackEndpoint(context.Context) (aws.DualStackEndpointState, bool, error) {
+	if v {
+		return aws.DualStackEndpointStateEnabled, true, nil
+	}
+
+	return aws.DualStackEndpointStateDisabled, true, nil
+}
+
+// logAPIClientConfig logs, at TRACE level, the endpoint-related configuration used to construct an AWS SDK for Go v2 API client.
+// An empty endpoint indicates that the endpoint is resolved by the AWS SDK.
+func logAPIClientConfig(ctx context.Context, config map[string]any) {
+	var useFIPS, useDualStack bool
+	if cfg, ok := config["aws_sdkv2_config"].(*aws.Config); ok && cfg != nil {
+		useFIPS, useDualStack = resolveUseFIPSEndpoint(ctx, cfg), resolveUseDualStackEndpoint(ctx, cfg)
+	}
+
+	tflog.Trace(ctx, "creating AWS SDK for Go v2 API client", map[string]any{
+		"tf_aws.endpoint":      config["endpoint"],
+		"tf_aws.region":        config["region"],
+		"tf_aws.use_dualstack": useDualStack,
+		"tf_aws.use_fips":      useFIPS,
+	})
+}
+
+// resolveUseFIPSEndpoint returns the first FIPS endpoint setting found in the configuration sources, in the same way as the AWS SDK.
+func resolveUseFIPSEndpoint(ctx context.Context, cfg *aws.Config) bool {
+	for _, v := range cfg.ConfigSources {
+		if v, ok := v.(interface {
+			GetUseFIPSEndpoint(context.Context) (aws.FIPSEndpointState, bool, error)
+		}); ok {
+			if state, found, err := v.GetUseFIPSEndpoint(ctx); err == nil && found {
+				return state == aws.FIPSEndpointStateEnabled
+			}
+		}
+	}
+
+	return false
+}
+
+// resolveUseDualStackEndpoint returns the first dual-stack endpoint setting found in the configuration sources, in the same way as the AWS SDK.
+func resolveUseDualStackEndpoint(ctx context.Context, cfg *aws.Config) bool {
+	for _, v := range cfg.ConfigSources {
+		if v, ok := v.(interface {
+			GetUseDualStackEndpoint(context.Context) (aws.DualStackEndpointState, bool, error)
+		}); ok {
+			if state, found, err := v.GetUseDualStackEndpoint(ctx); err == nil && found {
+				return state == aws.DualStackEndpointStateEnabled
+			}
+		}
+	}
+
+	return false
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
@@ -395,6 +459,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 
 	config := c.apiClientConfig(ctx, servicePackageName)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
+	logAPIClientConfig(ctx, config)
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
 		var zero T
@@ -4,10 +4,16 @@
 package conns
 
 import (
+	"bytes"
+	"context"
+	"maps"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 )
 
 var (
@@ -598,3 +604,149 @@ func TestAWSClientRegionalARNWithRegion(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+type testEndpointConfigSource struct {
+	useFIPSEndpoint      aws.FIPSEndpointState
+	useDualStackEndpoint aws.DualStackEndpointState
+}
+
+func (s testEndpointConfigSource) GetUseFIPSEndpoint(context.Context) (aws.FIPSEndpointState, bool, error) {
+	return s.useFIPSEndpoint, s.useFIPSEndpoint != aws.FIPSEndpointStateUnset, nil
+}
+
+func (s testEndpointConfigSource) GetUseDualStackEndpoint(context.Context) (aws.DualStackEndpointState, bool, error) {
+	return s.useDualStackEndpoint, s.useDualStackEndpoint != aws.DualStackEndpointStateUnset, nil
+}
+
+func TestLogAPIClientConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configSources []any
+		endpoint      string
+		expected      map[string]any
+	}{
+		"defaults": {
+			expected: map[string]any{
+				"tf_aws.endpoint":      "",
+				"tf_aws.use_dualstack": false,
+				"tf_aws.use_fips":      false,
+			},
+		},
+		"custom endpoint": {
+			endpoint: "https://sqs.example.com",
+			expected: map[string]any{
+				"tf_aws.endpoint":      "https://sqs.example.com",
+				"tf_aws.use_dualstack": false,
+				"tf_aws.use_fips":      false,
+			},
+		},
+		"FIPS and dual-stack": {
+			configSources: []any{
+				testEndpointConfigSource{
+					useFIPSEndpoint:      aws.FIPSEndpointStateEnabled,
+					useDualStackEndpoint: aws.DualStackEndpointStateEnabled,
+				},
+			},
+			expected: map[string]any{
+				"tf_aws.endpoint":      "",
+				"tf_aws.use_dualstack": true,
+				"tf_aws.use_fips":      true,
+			},
+		},
+		"first config source wins": {
+			configSources: []any{
+				testEndpointConfigSource{
+					useFIPSEndpoint: aws.FIPSEndpointStateDisabled,
+				},
+				testEndpointConfigSource{
+					useFIPSEndpoint:      aws.FIPSEndpointStateEnabled,
+					useDualStackEndpoint: aws.DualStackEndpointStateEnabled,
+				},
+			},
+			expected: map[string]any{
+				"tf_aws.endpoint":      "",
+				"tf_aws.use_dualstack": true,
+				"tf_aws.use_fips":      false,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			ctx := tflogtest.RootLogger(t.Context(), &buf)
+			ctx = tflog.SetField(ctx, "tf_aws.service_package", "sqs")
+
+			logAPIClientConfig(ctx, map[string]any{
+				"aws_sdkv2_config": &aws.Config{
+					ConfigSources: testCase.configSources,
+				},
+				"endpoint": testCase.endpoint,
+				"region":   endpoints.UsGovWest1RegionID,
+			})
+
+			lines, err := tflogtest.MultilineJSONDecode(&buf)
+			if err != nil {
+				t.Fatalf("decoding log lines: %s", err)
+			}
+
+			expected := map[string]any{
+				"@level":                 "trace",
+				"@message":               "creating AWS SDK for Go v2 API client",
+				"@module":                "provider",
+				"tf_aws.region":          endpoints.UsGovWest1RegionID,
+				"tf_aws.service_package": "sqs",
+			}
+			maps.Copy(expected, testCase.expected)
+
+			if diff := cmp.Diff([]map[string]any{expected}, lines); diff != "" {
+				t.Errorf("unexpected log lines difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIClientConfigUseDualStackEndpointOverrides(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	c := &AWSClient{
+		awsConfig: &aws.Config{
+			ConfigSources: []any{
+				testEndpointConfigSource{
+					useDualStackEndpoint: aws.DualStackEndpointStateEnabled,
+				},
+			},
+			Region: endpoints.UsWest2RegionID,
+		},
+		partition: standardPartition,
+		useDualStackEndpointOverrides: map[string]bool{
+			"s3":  true,
+			"sqs": false,
+		},
+	}
+
+	testCases := map[string]bool{
+		"ec2": true, // Provider-level setting.
+		"s3":  true,
+		"sqs": false,
+	}
+
+	for servicePackageName, expected := range testCases {
+		t.Run(servicePackageName, func(t *testing.T) {
+			cfg := c.apiClientConfig(ctx, servicePackageName)["aws_sdkv2_config"].(*aws.Config)
+
+			if got := resolveUseDualStackEndpoint(ctx, cfg); got != expected {
+				t.Errorf("got %t, expected %t", got, expected)
+			}
+		})
+	}
+
+	// The provider-level configuration is unchanged.
+	if got := resolveUseDualStackEndpoint(ctx, c.awsConfig); !got {
+		t.Errorf("provider-level configuration modified")
+	}
+}
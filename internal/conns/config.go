@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -45,6 +46,7 @@ type Config struct {
 	NoProxy                        string
 	Profile                        string
 	Region                         string
+	RequestTimeout                 time.Duration
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
@@ -60,6 +62,7 @@ type Config struct {
 	Token                          string
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
+	UseDualStackEndpointOverrides  map[string]bool
 	UseFIPSEndpoint                bool
 }
 
@@ -155,6 +158,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.RequestTimeout > 0 {
+		if v, ok := withRequestTimeout(cfg.HTTPClient, c.RequestTimeout); ok {
+			cfg.HTTPClient = v
+		} else {
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"request_timeout not applied",
+				fmt.Sprintf("The configured HTTP client (%T) does not support setting a request timeout. AWS API requests will use the client's own timeout.", cfg.HTTPClient)))
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -204,10 +217,22 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.useDualStackEndpointOverrides = c.UseDualStackEndpointOverrides
 
 	return client, diags
 }
 
+// withRequestTimeout returns a copy of the HTTP client with the specified overall request timeout.
+// Only clients built by the AWS SDK can be reconfigured; for any other client false is returned.
+func withRequestTimeout(client aws.HTTPClient, timeout time.Duration) (aws.HTTPClient, bool) {
+	v, ok := client.(*awshttp.BuildableClient)
+	if !ok {
+		return client, false
+	}
+
+	return v.WithTimeout(timeout), true
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
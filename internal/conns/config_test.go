@@ -9,7 +9,9 @@ import (
 	"maps"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
@@ -500,3 +502,234 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryConfig(t *testing.T) {
+	cases := map[string]struct {
+		config                   map[string]any
+		environmentVariables     map[string]string
+		expectedRetryMaxAttempts int
+		expectedRetryMode        aws.RetryMode
+	}{
+		"no config": {
+			config:                   map[string]any{},
+			expectedRetryMaxAttempts: 25,
+		},
+
+		"max_retries config": {
+			config: map[string]any{
+				"max_retries": 5,
+			},
+			expectedRetryMaxAttempts: 5,
+		},
+
+		"AWS_MAX_ATTEMPTS envvar": {
+			config: map[string]any{},
+			environmentVariables: map[string]string{
+				"AWS_MAX_ATTEMPTS": "10",
+			},
+			expectedRetryMaxAttempts: 10,
+		},
+
+		"max_retries config overrides AWS_MAX_ATTEMPTS envvar": {
+			config: map[string]any{
+				"max_retries": 5,
+			},
+			environmentVariables: map[string]string{
+				"AWS_MAX_ATTEMPTS": "10",
+			},
+			expectedRetryMaxAttempts: 5,
+		},
+
+		"retry_mode config": {
+			config: map[string]any{
+				"retry_mode": "adaptive",
+			},
+			expectedRetryMaxAttempts: 25,
+			expectedRetryMode:        aws.RetryModeAdaptive,
+		},
+
+		"AWS_RETRY_MODE envvar": {
+			config: map[string]any{},
+			environmentVariables: map[string]string{
+				"AWS_RETRY_MODE": "adaptive",
+			},
+			expectedRetryMaxAttempts: 25,
+			expectedRetryMode:        aws.RetryModeAdaptive,
+		},
+
+		"retry_mode config overrides AWS_RETRY_MODE envvar": {
+			config: map[string]any{
+				"retry_mode": "standard",
+			},
+			environmentVariables: map[string]string{
+				"AWS_RETRY_MODE": "adaptive",
+			},
+			expectedRetryMaxAttempts: 25,
+			expectedRetryMode:        aws.RetryModeStandard,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			config := map[string]any{
+				"access_key":                  "StaticAccessKey",
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-west-2",
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			for k, v := range tc.environmentVariables {
+				t.Setenv(k, v)
+			}
+
+			maps.Copy(config, tc.config)
+
+			p, err := sdkv2.NewProvider(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p.TerraformVersion = "1.0.0"
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			awsConfig := p.Meta().(*conns.AWSClient).AwsConfig(ctx)
+
+			if got, want := awsConfig.RetryMaxAttempts, tc.expectedRetryMaxAttempts; got != want {
+				t.Errorf("expected RetryMaxAttempts %d, got %d", want, got)
+			}
+
+			if tc.expectedRetryMode != "" {
+				if got, want := awsConfig.RetryMode, tc.expectedRetryMode; got != want {
+					t.Errorf("expected RetryMode %q, got %q", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestTimeoutConfig(t *testing.T) {
+	cases := map[string]struct {
+		config          map[string]any
+		expectedTimeout time.Duration
+	}{
+		"no config": {
+			config: map[string]any{},
+		},
+
+		"request_timeout config": {
+			config: map[string]any{
+				"request_timeout": "90s",
+			},
+			expectedTimeout: 90 * time.Second,
+		},
+
+		"request_timeout config with proxy": {
+			config: map[string]any{
+				"https_proxy":     "http://https-proxy.test:1234",
+				"request_timeout": "10m",
+			},
+			expectedTimeout: 10 * time.Minute,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			config := map[string]any{
+				"access_key":                  "StaticAccessKey",
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-west-2",
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			maps.Copy(config, tc.config)
+
+			p, err := sdkv2.NewProvider(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p.TerraformVersion = "1.0.0"
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			client := p.Meta().(*conns.AWSClient).AwsConfig(ctx).HTTPClient
+			bClient, ok := client.(*awshttp.BuildableClient)
+			if !ok {
+				t.Fatalf("expected awshttp.BuildableClient, got %T", client)
+			}
+
+			if got, want := bClient.GetTimeout(), tc.expectedTimeout; got != want {
+				t.Errorf("expected timeout %s, got %s", want, got)
+			}
+
+			// Setting a timeout must not discard the proxy configuration.
+			if v, ok := tc.config["https_proxy"].(string); ok {
+				req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+				pUrl, err := bClient.GetTransport().Proxy(req)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if pUrl == nil || pUrl.String() != v {
+					t.Errorf("expected proxy %q, got %v", v, pUrl)
+				}
+			}
+		})
+	}
+}
+
+type customHTTPClient struct{}
+
+func (customHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return nil, nil
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("buildable client", func(t *testing.T) {
+		t.Parallel()
+
+		client, ok := conns.WithRequestTimeout(awshttp.NewBuildableClient(), 90*time.Second)
+		if !ok {
+			t.Fatal("expected request timeout to be applied")
+		}
+
+		bClient, ok := client.(*awshttp.BuildableClient)
+		if !ok {
+			t.Fatalf("expected awshttp.BuildableClient, got %T", client)
+		}
+
+		if got, want := bClient.GetTimeout(), 90*time.Second; got != want {
+			t.Errorf("expected timeout %s, got %s", want, got)
+		}
+	})
+
+	t.Run("custom client", func(t *testing.T) {
+		t.Parallel()
+
+		var want aws.HTTPClient = customHTTPClient{}
+		client, ok := conns.WithRequestTimeout(want, 90*time.Second)
+		if ok {
+			t.Fatal("expected request timeout not to be applied")
+		}
+
+		if client != want {
+			t.Errorf("expected client to be returned unchanged, got %T", client)
+		}
+	})
+}
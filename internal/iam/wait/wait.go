@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package wait contains helpers for waiting on IAM eventual consistency.
+package wait
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// PropagationTimeout is the general timeout for IAM resource changes to propagate.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/troubleshoot_general.html#troubleshoot_general_eventual-consistency.
+const PropagationTimeout = 2 * time.Minute
+
+// RetryWhenNotPropagated retries the specified function when the error it returns satisfies `isNotPropagated`,
+// typically because a newly created or updated IAM role cannot yet be assumed by the calling service.
+// `f` is retried until PropagationTimeout expires.
+func RetryWhenNotPropagated[T any](ctx context.Context, f func(context.Context) (T, error), isNotPropagated func(error) bool) (T, error) {
+	return tfresource.RetryWhen(ctx, PropagationTimeout, f, func(err error) (bool, error) {
+		if isNotPropagated(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
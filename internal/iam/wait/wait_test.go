@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wait_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	iamwait "github.com/hashicorp/terraform-provider-aws/internal/iam/wait"
+)
+
+func TestRetryWhenNotPropagated(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	isAccessDenied := func(err error) bool {
+		return tfawserr.ErrCodeEquals(err, "AccessDenied")
+	}
+
+	t.Run("retries until AccessDenied clears", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		output, err := iamwait.RetryWhenNotPropagated(ctx, func(context.Context) (string, error) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				return "", errs.APIError("AccessDenied", "User is not authorized to perform: sts:AssumeRole")
+			}
+
+			return "ok", nil
+		}, isAccessDenied)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := output, "ok"; got != want {
+			t.Errorf("output = %q, want %q", got, want)
+		}
+
+		if got, want := atomic.LoadInt32(&calls), int32(3); got != want {
+			t.Errorf("calls = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		_, err := iamwait.RetryWhenNotPropagated(ctx, func(context.Context) (string, error) {
+			atomic.AddInt32(&calls, 1)
+
+			return "", errs.APIError("ValidationException", "invalid input")
+		}, isAccessDenied)
+
+		if !tfawserr.ErrCodeEquals(err, "ValidationException") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, want := atomic.LoadInt32(&calls), int32(1); got != want {
+			t.Errorf("calls = %d, want %d", got, want)
+		}
+	})
+}
@@ -151,6 +151,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum amount of time to wait for a single HTTP request to the AWS API to complete, e.g. `90s` or `10m`. By default there is no limit.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -211,6 +215,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Resolve an endpoint with DualStack capability",
 			},
+			"use_dualstack_endpoint_overrides": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Optional:    true,
+				Description: "Per-service overrides of `use_dualstack_endpoint`. Keys are service names as used in the `endpoints` block, e.g. `s3`.",
+			},
 			"use_fips_endpoint": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -36,6 +37,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// maxAttemptsEnvVar is the AWS SDK environment variable specifying the maximum number of attempts for an API call.
+	maxAttemptsEnvVar = "AWS_MAX_ATTEMPTS"
+)
+
 var (
 	resourceSchemasValidated bool
 )
@@ -179,9 +185,17 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS operations will take place. Examples\n" +
 						"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				},
+				"request_timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+					Description: "The maximum amount of time to wait for a single HTTP request to the AWS API to complete, e.g. `90s` or `10m`. " +
+						"By default there is no limit.",
+				},
 				"retry_mode": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(enum.Slice(aws.RetryModeStandard, aws.RetryModeAdaptive), false),
 					Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 						"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 				},
@@ -265,6 +279,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:    true,
 					Description: "Resolve an endpoint with DualStack capability",
 				},
+				"use_dualstack_endpoint_overrides": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeBool},
+					Description: "Per-service overrides of `use_dualstack_endpoint`. " +
+						"Keys are service names as used in the `endpoints` block, e.g. `s3`.",
+				},
 				"use_fips_endpoint": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -356,6 +377,26 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v, ok := d.GetOk("use_dualstack_endpoint_overrides"); ok {
+		overrides := make(map[string]bool)
+		for k, v := range v.(map[string]any) {
+			pkg, err := names.ProviderPackageForAlias(k)
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "use_dualstack_endpoint_overrides: %s", err)
+			}
+			overrides[pkg] = v.(bool)
+		}
+		config.UseDualStackEndpointOverrides = overrides
+	}
+
+	if v, ok := d.Get("request_timeout").(string); ok && v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.RequestTimeout = timeout
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -467,6 +508,9 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
+	} else if os.Getenv(maxAttemptsEnvVar) != "" {
+		// Let the AWS SDK read the maximum number of attempts from the environment.
+		config.MaxRetries = 0
 	}
 
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
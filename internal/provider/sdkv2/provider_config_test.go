@@ -5,9 +5,15 @@ package sdkv2
 
 import (
 	"context"
+	"fmt"
 	"maps"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -621,3 +627,117 @@ func TestProviderConfig_AssumeRole(t *testing.T) { //nolint:paralleltest
 		})
 	}
 }
+
+func TestProviderConfig_AssumeRoleWithWebIdentityTokenFileRotation(t *testing.T) { //nolint:paralleltest
+	ctx := t.Context()
+
+	servicemocks.InitSessionTestEnv(t)
+
+	const (
+		initialToken = "InitialWebIdentityToken"
+		rotatedToken = "RotatedWebIdentityToken"
+	)
+
+	var (
+		mu     sync.Mutex
+		tokens []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("Action") != "AssumeRoleWithWebIdentity" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		tokens = append(tokens, r.PostForm.Get("WebIdentityToken"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, testAssumeRoleWithWebIdentityResponseBody, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer ts.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "web-identity-token")
+	if err := os.WriteFile(tokenFile, []byte(initialToken), 0600); err != nil {
+		t.Fatalf("writing web identity token file: %s", err)
+	}
+
+	config := map[string]any{
+		"region":                      "us-west-2", //lintignore:AWSAT003
+		"skip_credentials_validation": true,
+		"skip_requesting_account_id":  true,
+		"endpoints": []any{
+			map[string]any{
+				"sts": ts.URL,
+			},
+		},
+		"assume_role_with_web_identity": []any{
+			map[string]any{
+				"role_arn":                "arn:aws:iam::555555555555:role/WebIdentityToken", //lintignore:AWSAT005
+				"session_name":            "WebIdentityTokenSession",
+				"web_identity_token_file": tokenFile,
+			},
+		},
+	}
+
+	rc := terraformsdk.NewResourceConfigRaw(config)
+
+	p, err := NewProvider(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p.TerraformVersion = "1.0.0"
+
+	if diags := p.Configure(ctx, rc); diags.HasError() {
+		t.Fatalf("configuring: %s", sdkdiag.DiagnosticsString(diags))
+	}
+
+	credentialsProvider := p.Meta().(*conns.AWSClient).CredentialsProvider(ctx)
+
+	if _, err := credentialsProvider.Retrieve(ctx); err != nil {
+		t.Fatalf("retrieving credentials: %s", err)
+	}
+
+	// Rotate the token and force the cached credentials to be refreshed.
+	if err := os.WriteFile(tokenFile, []byte(rotatedToken), 0600); err != nil {
+		t.Fatalf("writing web identity token file: %s", err)
+	}
+
+	cache, ok := credentialsProvider.(*aws.CredentialsCache)
+	if !ok {
+		t.Fatalf("expected *aws.CredentialsCache, got %T", credentialsProvider)
+	}
+	cache.Invalidate()
+
+	if _, err := cache.Retrieve(ctx); err != nil {
+		t.Fatalf("retrieving credentials: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(tokens) < 2 {
+		t.Fatalf("expected at least 2 AssumeRoleWithWebIdentity calls, got %d", len(tokens))
+	}
+	if got, want := tokens[0], initialToken; got != want {
+		t.Errorf("expected initial web identity token %q, got %q", want, got)
+	}
+	if got, want := tokens[len(tokens)-1], rotatedToken; got != want {
+		t.Errorf("expected rotated web identity token %q, got %q", want, got)
+	}
+}
+
+const testAssumeRoleWithWebIdentityResponseBody = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>AssumeRoleWithWebIdentityAccessKey</AccessKeyId>
+      <SecretAccessKey>AssumeRoleWithWebIdentitySecretKey</SecretAccessKey>
+      <SessionToken>AssumeRoleWithWebIdentitySessionToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
@@ -83,20 +83,17 @@ func resourceAnalyzer() *schema.Resource {
 									"analysis_rule": {
 										Type:     schema.TypeList,
 										Optional: true,
-										ForceNew: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"inclusion": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"account_ids": {
 																Type:     schema.TypeList,
 																Optional: true,
-																ForceNew: true,
 																Elem: &schema.Schema{
 																	Type:         schema.TypeString,
 																	ValidateFunc: verify.ValidAccountID,
@@ -105,7 +102,6 @@ func resourceAnalyzer() *schema.Resource {
 															"resource_arns": {
 																Type:     schema.TypeList,
 																Optional: true,
-																ForceNew: true,
 																Elem: &schema.Schema{
 																	Type:         schema.TypeString,
 																	ValidateFunc: verify.ValidARN,
@@ -114,7 +110,6 @@ func resourceAnalyzer() *schema.Resource {
 															"resource_types": {
 																Type:     schema.TypeList,
 																Optional: true,
-																ForceNew: true,
 																Elem: &schema.Schema{
 																	Type:             schema.TypeString,
 																	ValidateDiagFunc: enum.Validate[types.ResourceType](),
@@ -140,20 +135,17 @@ func resourceAnalyzer() *schema.Resource {
 									"analysis_rule": {
 										Type:     schema.TypeList,
 										Optional: true,
-										ForceNew: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"exclusion": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"account_ids": {
 																Type:     schema.TypeList,
 																Optional: true,
-																ForceNew: true,
 																MaxItems: 2000,
 																Elem: &schema.Schema{
 																	Type:         schema.TypeString,
@@ -163,7 +155,6 @@ func resourceAnalyzer() *schema.Resource {
 															names.AttrResourceTags: {
 																Type:     schema.TypeList,
 																Optional: true,
-																ForceNew: true,
 																Elem: &schema.Schema{
 																	Type: schema.TypeMap,
 																	Elem: &schema.Schema{
@@ -269,8 +260,23 @@ func resourceAnalyzerRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 func resourceAnalyzerUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	if d.HasChange(names.AttrConfiguration) {
+		input := accessanalyzer.UpdateAnalyzerInput{
+			AnalyzerName: aws.String(d.Id()),
+		}
 
-	// Tags only.
+		if v, ok := d.GetOk(names.AttrConfiguration); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.Configuration = expandAnalyzerConfiguration(v.([]any)[0].(map[string]any))
+		}
+
+		_, err := conn.UpdateAnalyzer(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM Access Analyzer Analyzer (%s): %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceAnalyzerRead(ctx, d, meta)...)
 }
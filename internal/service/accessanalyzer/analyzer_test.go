@@ -195,6 +195,22 @@ func testAccAnalyzer_accountUnusedAccess(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccAnalyzerConfig_accountUnusedAccessExclusion(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(ctx, resourceName, &analyzer),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.0.unused_access_age", "180"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.0.analysis_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.0.analysis_rule.0.exclusion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.0.analysis_rule.0.exclusion.0.resource_tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.0.analysis_rule.0.exclusion.0.resource_tags.0.key1", acctest.CtValue1),
+				),
+			},
 		},
 	})
 }
@@ -494,6 +510,28 @@ resource "aws_accessanalyzer_analyzer" "test" {
 `, rName)
 }
 
+func testAccAnalyzerConfig_accountUnusedAccessExclusion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+  type          = "ACCOUNT_UNUSED_ACCESS"
+
+  configuration {
+    unused_access {
+      unused_access_age = 180
+      analysis_rule {
+        exclusion {
+          resource_tags = [
+            { key1 = "value1" },
+          ]
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccAnalyzerConfig_organizationInternalAccess_resourceARNs(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -47,6 +47,7 @@ func resourceCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCertificateCreate,
 		ReadWithoutTimeout:   resourceCertificateRead,
+		UpdateWithoutTimeout: resourceCertificateUpdate,
 		DeleteWithoutTimeout: resourceCertificateRevoke,
 
 		// Expects ACM PCA ARN format, e.g:
@@ -66,6 +67,7 @@ func resourceCertificate() *schema.Resource {
 				}
 
 				d.Set("certificate_authority_arn", authorityARN)
+				d.Set("revocation_reason", types.RevocationReasonUnspecified)
 
 				return []*schema.ResourceData{d}, nil
 			},
@@ -96,6 +98,12 @@ func resourceCertificate() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"revocation_reason": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.RevocationReasonUnspecified,
+				ValidateDiagFunc: enum.Validate[types.RevocationReason](),
+			},
 			"signing_algorithm": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -212,6 +220,14 @@ func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta a
 	return diags
 }
 
+func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// revocation_reason is only used when the certificate is revoked on destroy.
+
+	return append(diags, resourceCertificateRead(ctx, d, meta)...)
+}
+
 func resourceCertificateRevoke(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ACMPCAClient(ctx)
@@ -234,7 +250,7 @@ func resourceCertificateRevoke(ctx context.Context, d *schema.ResourceData, meta
 	input := acmpca.RevokeCertificateInput{
 		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
 		CertificateSerial:       aws.String(serial.Text(16)), //nolint:mnd // Should be excluded, but not sure how to specify a method
-		RevocationReason:        types.RevocationReasonUnspecified,
+		RevocationReason:        types.RevocationReason(d.Get("revocation_reason").(string)),
 	}
 	_, err = conn.RevokeCertificate(ctx, &input)
 
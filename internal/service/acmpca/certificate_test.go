@@ -15,6 +15,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -199,6 +200,57 @@ func TestAccACMPCACertificate_endEntityCertificate(t *testing.T) {
 	})
 }
 
+func TestAccACMPCACertificate_endEntityCertificateCustomTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_acmpca_certificate.test"
+	csrDomain := acctest.RandomDomainName()
+	csr, _ := acctest.TLSRSAX509CertificateRequestPEM(t, 4096, csrDomain)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMPCAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_endEntityCustomTemplate(domain, acctest.TLSPEMEscapeNewlines(csr), "SUPERSEDED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCertificate),
+					resource.TestCheckResourceAttr(resourceName, "revocation_reason", "SUPERSEDED"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "template_arn", "acm-pca", "template/EndEntityServerAuthCertificate_APIPassthrough/V1"),
+				),
+			},
+			{
+				Config: testAccCertificateConfig_endEntityCustomTemplate(domain, acctest.TLSPEMEscapeNewlines(csr), "KEY_COMPROMISE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "revocation_reason", "KEY_COMPROMISE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"api_passthrough",
+					"certificate_signing_request",
+					"revocation_reason",
+					"signing_algorithm",
+					"template_arn",
+					"validity",
+				},
+			},
+		},
+	})
+}
+
 func TestAccACMPCACertificate_Validity_endDate(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_acmpca_certificate.test"
@@ -489,6 +541,36 @@ resource "aws_acmpca_certificate" "test" {
 `, csr))
 }
 
+func testAccCertificateConfig_endEntityCustomTemplate(domain, csr, revocationReason string) string {
+	return acctest.ConfigCompose(
+		testAccCertificateBaseRootCAConfig(domain),
+		fmt.Sprintf(`
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.root.arn
+  certificate_signing_request = "%[1]s"
+  signing_algorithm           = "SHA256WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/EndEntityServerAuthCertificate_APIPassthrough/V1"
+
+  api_passthrough = jsonencode({
+    Extensions = {
+      KeyUsage = {
+        DigitalSignature = true
+        KeyEncipherment  = true
+      }
+    }
+  })
+
+  revocation_reason = %[2]q
+
+  validity {
+    type  = "DAYS"
+    value = 1
+  }
+}
+`, csr, revocationReason))
+}
+
 func testAccCertificateConfig_validityEndDate(domain, csr, expiry string) string {
 	return acctest.ConfigCompose(
 		testAccCertificateBaseRootCAConfig(domain),
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -40,6 +41,18 @@ func dataSourceWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrLoggingConfiguration: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"prometheus_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,6 +89,18 @@ func dataSourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta a
 	d.Set("prometheus_endpoint", workspace.PrometheusEndpoint)
 	d.Set(names.AttrStatus, workspace.Status.StatusCode)
 
+	loggingConfiguration, err := findLoggingConfigurationByWorkspaceID(ctx, conn, workspaceID)
+
+	if tfresource.NotFound(err) {
+		d.Set(names.AttrLoggingConfiguration, nil)
+	} else if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Prometheus Workspace (%s) logging configuration: %s", workspaceID, err)
+	} else {
+		if err := d.Set(names.AttrLoggingConfiguration, []any{flattenLoggingConfigurationMetadata(loggingConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting logging_configuration: %s", err)
+		}
+	}
+
 	setTagsOut(ctx, workspace.Tags)
 
 	return diags
@@ -37,6 +37,7 @@ func TestAccAMPWorkspaceDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, dataSourceName, names.AttrARN),
 					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreatedDate),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyARN, dataSourceName, names.AttrKMSKeyARN),
+					resource.TestCheckResourceAttr(dataSourceName, "logging_configuration.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "prometheus_endpoint", dataSourceName, "prometheus_endpoint"),
 					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
 				),
@@ -48,6 +49,31 @@ func TestAccAMPWorkspaceDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccAMPWorkspaceDataSource_loggingConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_prometheus_workspace.test"
+	dataSourceName := "data.aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AMPEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AMPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceDataSourceConfig_loggingConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_configuration.0.log_group_arn", dataSourceName, "logging_configuration.0.log_group_arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccWorkspaceDataSourceConfig_alias(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_prometheus_workspace" "test" {
@@ -59,3 +85,23 @@ data "aws_prometheus_workspace" "test" {
 }
 `, rName)
 }
+
+func testAccWorkspaceDataSourceConfig_loggingConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_prometheus_workspace" "test" {
+  alias = %[1]q
+
+  logging_configuration {
+    log_group_arn = "${aws_cloudwatch_log_group.test.arn}:*"
+  }
+}
+
+data "aws_prometheus_workspace" "test" {
+  workspace_id = aws_prometheus_workspace.test.id
+}
+`, rName)
+}
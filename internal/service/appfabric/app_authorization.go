@@ -122,6 +122,9 @@ func (r *appAuthorizationResource) Schema(ctx context.Context, request resource.
 					Blocks: map[string]schema.Block{
 						"api_key_credential": schema.ListNestedBlock{
 							CustomType: fwtypes.NewListNestedObjectTypeOf[apiKeyCredentialModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"api_key": schema.StringAttribute{
@@ -164,7 +167,7 @@ func (r *appAuthorizationResource) Schema(ctx context.Context, request resource.
 				CustomType: fwtypes.NewListNestedObjectTypeOf[tenantModel](ctx),
 				Validators: []validator.List{
 					listvalidator.IsRequired(),
-					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
 				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -402,6 +405,46 @@ func (r *appAuthorizationResource) Delete(ctx context.Context, request resource.
 	}
 }
 
+func (r *appAuthorizationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data appAuthorizationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AuthType.IsUnknown() || data.AuthType.IsNull() || data.Credential.IsUnknown() {
+		return
+	}
+
+	credential, d := data.Credential.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() || credential == nil {
+		return
+	}
+
+	// The credential supplied must match the authorization type.
+	authType := data.AuthType.ValueString()
+	credentialPath := path.Root("credential").AtListIndex(0)
+	apiKeyCredentialPath, oauth2CredentialPath := credentialPath.AtName("api_key_credential"), credentialPath.AtName("oauth2_credential")
+
+	switch data.AuthType.ValueEnum() {
+	case awstypes.AuthTypeApiKey:
+		if credential.ApiKeyCredential.IsNull() || len(credential.ApiKeyCredential.Elements()) == 0 {
+			response.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(apiKeyCredentialPath, path.Root("auth_type"), authType))
+		}
+		if !credential.Oauth2Credential.IsNull() && len(credential.Oauth2Credential.Elements()) > 0 {
+			response.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(oauth2CredentialPath, path.Root("auth_type"), authType))
+		}
+	case awstypes.AuthTypeOauth2:
+		if credential.Oauth2Credential.IsNull() || len(credential.Oauth2Credential.Elements()) == 0 {
+			response.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(oauth2CredentialPath, path.Root("auth_type"), authType))
+		}
+		if !credential.ApiKeyCredential.IsNull() && len(credential.ApiKeyCredential.Elements()) > 0 {
+			response.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(apiKeyCredentialPath, path.Root("auth_type"), authType))
+		}
+	}
+}
+
 func findAppAuthorizationByTwoPartKey(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleIdentifier string) (*awstypes.AppAuthorization, error) {
 	in := &appfabric.GetAppAuthorizationInput{
 		AppAuthorizationIdentifier: aws.String(appAuthorizationARN),
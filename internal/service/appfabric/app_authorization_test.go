@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/appfabric/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -218,6 +219,28 @@ func testAccAppAuthorization_oath2Update(t *testing.T) {
 	})
 }
 
+func testAccAppAuthorization_credentialMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApNortheast1RegionID, endpoints.EuWest1RegionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAppAuthorizationConfig_credentialMismatch(rName),
+				ExpectError: regexache.MustCompile(`Attribute "credential\[0\].oauth2_credential" must be specified when\s+"auth_type" is "oauth2"`),
+			},
+		},
+	})
+}
+
 func testAccCheckAppAuthorizationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
@@ -370,3 +393,29 @@ resource "aws_appfabric_app_authorization" "test" {
 }
 `, rName)
 }
+
+func testAccAppAuthorizationConfig_credentialMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appfabric_app_bundle" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_appfabric_app_authorization" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  app            = "DROPBOX"
+  auth_type      = "oauth2"
+
+  credential {
+    api_key_credential {
+      api_key = "ApiExampleKey"
+    }
+  }
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = "test"
+  }
+}
+`, rName)
+}
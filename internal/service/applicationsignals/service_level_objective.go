@@ -0,0 +1,714 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+func newServiceLevelObjectiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serviceLevelObjectiveResource{}
+
+	return r, nil
+}
+
+const (
+	ResNameServiceLevelObjective = "Service Level Objective"
+)
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithModel[serviceLevelObjectiveResourceModel]
+	framework.WithImportByID
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	comparisonOperator := fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator]()
+	durationUnit := fwtypes.StringEnumType[awstypes.DurationUnit]()
+	metricType := fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType]()
+
+	intervalAttributes := func(withStartTime bool) map[string]schema.Attribute {
+		attributes := map[string]schema.Attribute{
+			names.AttrDuration: schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"duration_unit": schema.StringAttribute{
+				CustomType: durationUnit,
+				Required:   true,
+			},
+		}
+
+		if withStartTime {
+			attributes[names.AttrStartTime] = schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			}
+		}
+
+		return attributes
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrLastUpdatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 10080),
+							},
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"interval": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: intervalAttributes(true),
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: intervalAttributes(false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: comparisonOperator,
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: metricType,
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: comparisonOperator,
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: metricType,
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int32{
+											int32validator.Between(60, 900),
+										},
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("request_based_sli_config"),
+			path.MatchRoot("sli_config"),
+		),
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var plan serviceLevelObjectiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &applicationsignals.CreateServiceLevelObjectiveInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateServiceLevelObjective(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ApplicationSignals, create.ErrActionCreating, ResNameServiceLevelObjective, plan.Name.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.Slo == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ApplicationSignals, create.ErrActionCreating, ResNameServiceLevelObjective, plan.Name.ValueString(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, out.Slo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var state serviceLevelObjectiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findServiceLevelObjectiveByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ApplicationSignals, create.ErrActionReading, ResNameServiceLevelObjective, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var plan, state serviceLevelObjectiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := fwflex.Diff(ctx, plan, state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		in := &applicationsignals.UpdateServiceLevelObjectiveInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.Id = state.ID.ValueStringPointer()
+
+		out, err := conn.UpdateServiceLevelObjective(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.ApplicationSignals, create.ErrActionUpdating, ResNameServiceLevelObjective, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil || out.Slo == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.ApplicationSignals, create.ErrActionUpdating, ResNameServiceLevelObjective, state.ID.ValueString(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.flatten(ctx, out.Slo)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.LastUpdatedTime = state.LastUpdatedTime
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var state serviceLevelObjectiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: state.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteServiceLevelObjective(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ApplicationSignals, create.ErrActionDeleting, ResNameServiceLevelObjective, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	in := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	out, err := conn.GetServiceLevelObjective(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Slo, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                                `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configuration"`
+	CreatedTime            timetypes.RFC3339                                           `tfsdk:"created_time"`
+	Description            types.String                                                `tfsdk:"description"`
+	EvaluationType         fwtypes.StringEnum[awstypes.EvaluationType]                 `tfsdk:"evaluation_type"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	ID                     types.String                                                `tfsdk:"id"`
+	LastUpdatedTime        timetypes.RFC3339                                           `tfsdk:"last_updated_time"`
+	Name                   types.String                                                `tfsdk:"name"`
+	RequestBasedSliConfig  fwtypes.ListNestedObjectValueOf[requestBasedSLIConfigModel] `tfsdk:"request_based_sli_config"`
+	SliConfig              fwtypes.ListNestedObjectValueOf[sliConfigModel]             `tfsdk:"sli_config"`
+	Tags                   tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+// flatten sets the model's values from the service level objective returned by the API.
+// The API describes SLIs using a different shape than the one used to configure them,
+// so the SLI blocks are flattened from their configuration equivalents.
+func (m *serviceLevelObjectiveResourceModel) flatten(ctx context.Context, slo *awstypes.ServiceLevelObjective) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, slo, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = fwflex.StringToFramework(ctx, slo.Name)
+
+	sli := struct {
+		RequestBasedSliConfig *awstypes.RequestBasedServiceLevelIndicatorConfig
+		SliConfig             *awstypes.ServiceLevelIndicatorConfig
+	}{
+		RequestBasedSliConfig: requestBasedSLIConfigFromRequestBasedSLI(slo.RequestBasedSli),
+		SliConfig:             sliConfigFromSLI(slo.Sli),
+	}
+	diags.Append(fwflex.Flatten(ctx, sli, m)...)
+
+	return diags
+}
+
+func sliConfigFromSLI(apiObject *awstypes.ServiceLevelIndicator) *awstypes.ServiceLevelIndicatorConfig {
+	if apiObject == nil {
+		return nil
+	}
+
+	config := &awstypes.ServiceLevelIndicatorConfig{
+		ComparisonOperator: apiObject.ComparisonOperator,
+		MetricThreshold:    apiObject.MetricThreshold,
+	}
+
+	if v := apiObject.SliMetric; v != nil {
+		metricConfig := &awstypes.ServiceLevelIndicatorMetricConfig{
+			KeyAttributes: v.KeyAttributes,
+			MetricType:    v.MetricType,
+			OperationName: v.OperationName,
+		}
+
+		// Statistic and period are returned as part of the generated metric query.
+		if len(v.MetricDataQueries) == 1 {
+			if stat := v.MetricDataQueries[0].MetricStat; stat != nil {
+				metricConfig.PeriodSeconds = stat.Period
+				metricConfig.Statistic = stat.Stat
+			}
+		}
+
+		config.SliMetricConfig = metricConfig
+	}
+
+	return config
+}
+
+func requestBasedSLIConfigFromRequestBasedSLI(apiObject *awstypes.RequestBasedServiceLevelIndicator) *awstypes.RequestBasedServiceLevelIndicatorConfig {
+	if apiObject == nil {
+		return nil
+	}
+
+	config := &awstypes.RequestBasedServiceLevelIndicatorConfig{
+		ComparisonOperator: apiObject.ComparisonOperator,
+		MetricThreshold:    apiObject.MetricThreshold,
+	}
+
+	if v := apiObject.RequestBasedSliMetric; v != nil {
+		config.RequestBasedSliMetricConfig = &awstypes.RequestBasedServiceLevelIndicatorMetricConfig{
+			KeyAttributes: v.KeyAttributes,
+			MetricType:    v.MetricType,
+			OperationName: v.OperationName,
+		}
+	}
+
+	return config
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int32 `tfsdk:"look_back_window_minutes"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+func (m intervalModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CalendarInterval.IsNull():
+		calendarIntervalData, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, calendarIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RollingInterval.IsNull():
+		rollingIntervalData, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, rollingIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var model calendarIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfNull[rollingIntervalModel](ctx)
+
+		return diags
+
+	case awstypes.IntervalMemberRollingInterval:
+		var model rollingIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfNull[calendarIntervalModel](ctx)
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	default:
+		return diags
+	}
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type requestBasedSLIConfigModel struct {
+	ComparisonOperator          fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold             types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSliMetricConfig fwtypes.ListNestedObjectValueOf[requestBasedSLIMetricConfigModel]    `tfsdk:"request_based_sli_metric_config"`
+}
+
+type requestBasedSLIMetricConfigModel struct {
+	KeyAttributes fwtypes.MapOfString                                          `tfsdk:"key_attributes"`
+	MetricType    fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName types.String                                                 `tfsdk:"operation_name"`
+}
+
+type sliConfigModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SliMetricConfig    fwtypes.ListNestedObjectValueOf[sliMetricConfigModel]                `tfsdk:"sli_metric_config"`
+}
+
+type sliMetricConfigModel struct {
+	KeyAttributes fwtypes.MapOfString                                          `tfsdk:"key_attributes"`
+	MetricType    fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds types.Int32                                                  `tfsdk:"period_seconds"`
+	Statistic     types.String                                                 `tfsdk:"statistic"`
+}
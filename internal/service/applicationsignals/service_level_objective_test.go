@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "application-signals", "slo/{name}"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", string(awstypes.EvaluationTypePeriodBased)),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", string(awstypes.DurationUnitDay)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.comparison_operator", string(awstypes.ServiceLevelIndicatorComparisonOperatorLessThan)),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.metric_threshold", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.sli_metric_config.0.key_attributes.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.sli_metric_config.0.metric_type", string(awstypes.ServiceLevelIndicatorMetricTypeLatency)),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.sli_metric_config.0.period_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.sli_metric_config.0.statistic", "p99"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfapplicationsignals.ResourceServiceLevelObjective, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.5"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.warning_threshold", "50"),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.0.metric_threshold", "1500"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_requestBased(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBased(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", string(awstypes.EvaluationTypeRequestBased)),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration_unit", string(awstypes.DurationUnitMonth)),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli_config.0.request_based_sli_metric_config.0.metric_type", string(awstypes.ServiceLevelIndicatorMetricTypeAvailability)),
+					resource.TestCheckResourceAttr(resourceName, "sli_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_service_level_objective" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceLevelObjectiveExists(ctx context.Context, n string, v *awstypes.ServiceLevelObjective) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		output, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    attainment_goal = 99.9
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  sli_config {
+    comparison_operator = "LessThan"
+    metric_threshold    = 2000
+
+    sli_metric_config {
+      key_attributes = {
+        Type        = "Service"
+        Name        = %[1]q
+        Environment = "generic:default"
+      }
+      metric_type    = "LATENCY"
+      operation_name = "GET /"
+      period_seconds = 60
+      statistic      = "p99"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  burn_rate_configuration {
+    look_back_window_minutes = 60
+  }
+
+  goal {
+    attainment_goal   = 99.5
+    warning_threshold = 50
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  sli_config {
+    comparison_operator = "LessThan"
+    metric_threshold    = 1500
+
+    sli_metric_config {
+      key_attributes = {
+        Type        = "Service"
+        Name        = %[1]q
+        Environment = "generic:default"
+      }
+      metric_type    = "LATENCY"
+      operation_name = "GET /"
+      period_seconds = 60
+      statistic      = "p99"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_requestBased(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    attainment_goal = 99
+
+    interval {
+      calendar_interval {
+        duration      = 1
+        duration_unit = "MONTH"
+        start_time    = "2025-01-01T00:00:00Z"
+      }
+    }
+  }
+
+  request_based_sli_config {
+    request_based_sli_metric_config {
+      key_attributes = {
+        Type        = "Service"
+        Name        = %[1]q
+        Environment = "generic:default"
+      }
+      metric_type    = "AVAILABILITY"
+      operation_name = "GET /"
+    }
+  }
+}
+`, rName)
+}
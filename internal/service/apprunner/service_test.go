@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -121,6 +122,49 @@ func TestAccAppRunnerService_ImageRepository_autoScaling(t *testing.T) {
 	})
 }
 
+func TestAccAppRunnerService_ImageRepository_autoScalingUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	autoScalingName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	autoScalingName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+	autoScalingResourceName1 := "aws_apprunner_auto_scaling_configuration_version.test1"
+	autoScalingResourceName2 := "aws_apprunner_auto_scaling_configuration_version.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppRunnerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_ImageRepository_autoScalingConfigurationSelect(rName, autoScalingName1, autoScalingName2, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", autoScalingResourceName1, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccServiceConfig_ImageRepository_autoScalingConfigurationSelect(rName, autoScalingName1, autoScalingName2, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", autoScalingResourceName2, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAppRunnerService_ImageRepository_encryption(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -385,7 +429,6 @@ func TestAccAppRunnerService_ImageRepository_networkConfiguration(t *testing.T)
 func TestAccAppRunnerService_ImageRepository_observabilityConfiguration(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_apprunner_service.test"
 	observabilityConfigurationResourceName := "aws_apprunner_observability_configuration.test"
 
@@ -410,7 +453,12 @@ func TestAccAppRunnerService_ImageRepository_observabilityConfiguration(t *testi
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccServiceConfig_ImageRepository_observabilityConfiguration_disabled(rName2),
+				Config: testAccServiceConfig_ImageRepository_observabilityConfiguration_disabled(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "observability_configuration.#", "1"),
@@ -683,6 +731,38 @@ resource "aws_apprunner_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_ImageRepository_autoScalingConfigurationSelect(rName, autoScalingName1, autoScalingName2, selected string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test1" {
+  auto_scaling_configuration_name = %[2]q
+}
+
+resource "aws_apprunner_auto_scaling_configuration_version" "test2" {
+  auto_scaling_configuration_name = %[3]q
+
+  max_concurrency = 50
+  max_size        = 10
+}
+
+resource "aws_apprunner_service" "test" {
+  auto_scaling_configuration_arn = aws_apprunner_auto_scaling_configuration_version.%[4]s.arn
+
+  service_name = %[1]q
+
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+}
+`, rName, autoScalingName1, autoScalingName2, selected)
+}
+
 func testAccServiceConfig_ImageRepository_encryptionConfiguration(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
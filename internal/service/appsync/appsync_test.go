@@ -60,6 +60,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			acctest.CtBasic:      testAccAppSyncSourceAPIAssociation_basic,
 			acctest.CtDisappears: testAccAppSyncSourceAPIAssociation_disappears,
 			"update":             testAccAppSyncSourceAPIAssociation_update,
+			"mergeType":          testAccAppSyncSourceAPIAssociation_mergeType,
 		},
 	}
 
@@ -6,6 +6,7 @@ package appsync
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -198,6 +199,17 @@ func (r *sourceAPIAssociationResource) Create(ctx context.Context, request resou
 	}
 	plan.ID = types.StringValue(id)
 
+	// Source APIs associated using MANUAL_MERGE are not merged until a schema merge is started.
+	if isManualMergeSourceAPIAssociation(out.SourceApiAssociation) {
+		if err := startSourceAPIAssociationSchemaMerge(ctx, conn, plan.AssociationID.ValueString(), aws.ToString(out.SourceApiAssociation.MergedApiArn)); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.AppSync, create.ErrActionCreating, resNameSourceAPIAssociation, plan.MergedAPIID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	_, err = waitSourceAPIAssociationCreated(ctx, conn, plan.AssociationID.ValueString(), aws.ToString(out.SourceApiAssociation.MergedApiArn), createTimeout)
 	if err != nil {
@@ -301,6 +313,16 @@ func (r *sourceAPIAssociationResource) Update(ctx context.Context, request resou
 			)
 			return
 		}
+
+		if isManualMergeSourceAPIAssociation(out.SourceApiAssociation) {
+			if err := startSourceAPIAssociationSchemaMerge(ctx, conn, plan.AssociationID.ValueString(), plan.MergedAPIARN.ValueString()); err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.AppSync, create.ErrActionUpdating, resNameSourceAPIAssociation, plan.ID.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
 	}
 
 	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
@@ -386,6 +408,24 @@ func findSourceAPIAssociation(ctx context.Context, conn *appsync.Client, input *
 	return output.SourceApiAssociation, nil
 }
 
+func isManualMergeSourceAPIAssociation(apiObject *awstypes.SourceApiAssociation) bool {
+	return apiObject != nil && apiObject.SourceApiAssociationConfig != nil && apiObject.SourceApiAssociationConfig.MergeType == awstypes.MergeTypeManualMerge
+}
+
+func startSourceAPIAssociationSchemaMerge(ctx context.Context, conn *appsync.Client, associationID, mergedAPIID string) error {
+	input := appsync.StartSchemaMergeInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+	_, err := conn.StartSchemaMerge(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("starting schema merge: %w", err)
+	}
+
+	return nil
+}
+
 func statusSourceAPIAssociation(ctx context.Context, conn *appsync.Client, associationID, mergedAPIID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findSourceAPIAssociationByTwoPartKey(ctx, conn, associationID, mergedAPIID)
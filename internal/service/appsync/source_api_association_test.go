@@ -108,6 +108,52 @@ func testAccAppSyncSourceAPIAssociation_update(t *testing.T) {
 	})
 }
 
+func testAccAppSyncSourceAPIAssociation_mergeType(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var sourceapiassociation, sourceapiassociationUpdated types.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_mergeType(rName, "MANUAL_MERGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &sourceapiassociation),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "MANUAL_MERGE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceAPIAssociationConfig_mergeType(rName, "AUTO_MERGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &sourceapiassociationUpdated),
+					testAccCheckSourceAPIAssociationNotRecreated(&sourceapiassociation, &sourceapiassociationUpdated),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "AUTO_MERGE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAppSyncSourceAPIAssociation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -272,3 +318,68 @@ resource "aws_appsync_source_api_association" "test" {
 }
 `, rName, description)
 }
+
+func testAccSourceAPIAssociationConfig_mergeType(rName, mergeType string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  assume_role_policy = data.aws_iam_policy_document.test.json
+  name_prefix        = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      identifiers = ["appsync.amazonaws.com"]
+      type        = "Service"
+    }
+    condition {
+      test     = "StringEquals"
+      values   = [data.aws_caller_identity.current.account_id]
+      variable = "aws:SourceAccount"
+    }
+
+    condition {
+      test     = "ArnLike"
+      values   = ["arn:${data.aws_partition.current.partition}:appsync:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}::apis/*"]
+      variable = "aws:SourceArn"
+    }
+  }
+}
+
+resource "aws_appsync_graphql_api" "merged" {
+  authentication_type           = "API_KEY"
+  name                          = %[1]q
+  api_type                      = "MERGED"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+}
+
+resource "aws_appsync_graphql_api" "source" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+  schema              = <<EOF
+schema {
+    query: Query
+}
+type Query {
+  test: Int
+}
+EOF
+}
+
+resource "aws_appsync_source_api_association" "test" {
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+
+  source_api_association_config {
+    merge_type = %[2]q
+  }
+}
+`, rName, mergeType)
+}
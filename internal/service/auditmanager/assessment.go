@@ -285,7 +285,12 @@ func (r *assessmentResource) Update(ctx context.Context, request resource.Update
 		}
 
 		new.Status = fwflex.StringValueToFramework(ctx, output.Assessment.Metadata.Status)
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Assessment.Metadata.Roles, &new.RolesAll)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	} else {
+		new.RolesAll = old.RolesAll
 		new.Status = old.Status
 	}
 
@@ -317,6 +322,30 @@ func (r *assessmentResource) Delete(ctx context.Context, request resource.Delete
 	}
 }
 
+func (r *assessmentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var plan, state assessmentResourceModel
+		response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.Roles.Equal(state.Roles) {
+			// If the configured roles change, the full set of roles with access is recomputed.
+			plan.RolesAll = fwtypes.NewListNestedObjectValueOfUnknown[roleModel](ctx)
+		}
+
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
 func findAssessmentByID(ctx context.Context, conn *auditmanager.Client, id string) (*awstypes.Assessment, error) {
 	input := auditmanager.GetAssessmentInput{
 		AssessmentId: aws.String(id),
@@ -12,7 +12,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
@@ -180,6 +182,69 @@ func TestAccAuditManagerAssessment_optional(t *testing.T) {
 	})
 }
 
+func TestAccAuditManagerAssessment_scopeAndRoles(t *testing.T) {
+	ctx := acctest.Context(t)
+	var assessment types.Assessment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentConfig_scopeAndRoles1(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(ctx, resourceName, &assessment),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "roles_all.*", map[string]string{
+						"role_type": "PROCESS_OWNER",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_services.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scope.0.aws_services.*", map[string]string{
+						names.AttrServiceName: "S3",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"roles"},
+			},
+			{
+				Config: testAccAssessmentConfig_scopeAndRoles2(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("roles_all")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(ctx, resourceName, &assessment),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "roles_all.*.role_arn", "aws_iam_role.test2", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_services.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scope.0.aws_services.*", map[string]string{
+						names.AttrServiceName: "EC2",
+					}),
+				),
+			},
+			{
+				Config:   testAccAssessmentConfig_scopeAndRoles2(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAssessmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient(ctx)
@@ -305,6 +370,97 @@ resource "aws_auditmanager_assessment" "test" {
 `, rName))
 }
 
+func testAccAssessmentConfig_scopeAndRoles1(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssessmentConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name = %[1]q
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  framework_id = aws_auditmanager_framework.test.id
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+
+    aws_services {
+      service_name = "S3"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAssessmentConfig_scopeAndRoles2(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssessmentConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Effect = "Allow"
+        Sid    = ""
+        Principal = {
+          Service = "ec2.amazonaws.com"
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_auditmanager_assessment" "test" {
+  name = %[1]q
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  framework_id = aws_auditmanager_framework.test.id
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  roles {
+    role_arn  = aws_iam_role.test2.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+
+    aws_services {
+      service_name = "S3"
+    }
+
+    aws_services {
+      service_name = "EC2"
+    }
+  }
+}
+`, rName))
+}
+
 func testAccAssessmentConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccAssessmentConfig_base(rName),
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -128,6 +129,46 @@ func TestAccBackupRestoreTestingSelection_updates(t *testing.T) {
 	})
 }
 
+func TestAccBackupRestoreTestingSelection_protectedResourceARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var restoretestingplan awstypes.RestoreTestingSelectionForGet
+	resourceName := "aws_backup_restore_testing_selection.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_protectedResourceARNs(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(ctx, resourceName, &restoretestingplan),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "protected_resource_arns.*", "aws_ebs_volume.test1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccRestoreTestingSelectionConfig_protectedResourceARNs(rName, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(ctx, resourceName, &restoretestingplan),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "protected_resource_arns.*", "aws_ebs_volume.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRestoreTestingSelectionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -267,3 +308,30 @@ resource "aws_backup_restore_testing_selection" "test" {
 }
 `, rName))
 }
+
+func testAccRestoreTestingSelectionConfig_protectedResourceARNs(rName, volumeName string) string {
+	return acctest.ConfigCompose(
+		testAccRestoreTestingSelectionConfig_base(rName),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test1" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+}
+
+resource "aws_ebs_volume" "test2" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+}
+
+resource "aws_backup_restore_testing_selection" "test" {
+  name = %[1]q
+
+  restore_testing_plan_name = aws_backup_restore_testing_plan.test.name
+  protected_resource_type   = "EBS"
+  iam_role_arn              = aws_iam_role.test.arn
+
+  protected_resource_arns = [aws_ebs_volume.%[2]s.arn]
+}
+`, rName, volumeName))
+}
@@ -29,6 +29,7 @@ func TestAccBedrock_serial(t *testing.T) {
 		"ModelInvocationLoggingConfiguration": {
 			acctest.CtBasic:      testAccModelInvocationLoggingConfiguration_basic,
 			acctest.CtDisappears: testAccModelInvocationLoggingConfiguration_disappears,
+			"noDestination":      testAccModelInvocationLoggingConfiguration_noDestination,
 			"upgradeV6.0.0":      testAccModelInvocationLoggingConfiguration_upgrade_V6_0_0,
 			"Identity":           testAccBedrockModelInvocationLoggingConfiguration_IdentitySerial,
 		},
@@ -134,6 +134,70 @@ func (r *modelInvocationLoggingConfigurationResource) Schema(ctx context.Context
 	}
 }
 
+func (r *modelInvocationLoggingConfigurationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data modelInvocationLoggingConfigurationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.LoggingConfig.IsNull() || data.LoggingConfig.IsUnknown() {
+		return
+	}
+
+	loggingConfig, diags := data.LoggingConfig.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || loggingConfig == nil {
+		return
+	}
+
+	if loggingConfig.CloudWatchConfig.IsUnknown() || loggingConfig.S3Config.IsUnknown() {
+		return
+	}
+
+	cloudWatchConfig, diags := loggingConfig.CloudWatchConfig.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	s3Config, diags := loggingConfig.S3Config.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Invocation logs must be delivered somewhere.
+	if cloudWatchConfig == nil && s3Config == nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root("logging_config"),
+			"Missing Attribute Configuration",
+			"at least one of logging_config.cloudwatch_config or logging_config.s3_config must be configured",
+		)
+	}
+
+	if cloudWatchConfig != nil {
+		if cloudWatchConfig.LogGroupName.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("logging_config"),
+				"Missing Attribute Configuration",
+				"logging_config.cloudwatch_config.log_group_name must be configured",
+			)
+		}
+		if cloudWatchConfig.RoleArn.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("logging_config"),
+				"Missing Attribute Configuration",
+				"logging_config.cloudwatch_config.role_arn must be configured",
+			)
+		}
+	}
+
+	if s3Config != nil && s3Config.BucketName.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("logging_config"),
+			"Missing Attribute Configuration",
+			"logging_config.s3_config.bucket_name must be configured",
+		)
+	}
+}
+
 func (r *modelInvocationLoggingConfigurationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	schemaV0 := modelInvocationLoggingConfigurationSchemaV0(ctx)
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -77,6 +78,23 @@ func testAccModelInvocationLoggingConfiguration_basic(t *testing.T) {
 	})
 }
 
+func testAccModelInvocationLoggingConfiguration_noDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccModelInvocationLoggingConfigurationConfig_noDestination(),
+				ExpectError: regexache.MustCompile(`at least one of logging_config.cloudwatch_config`),
+			},
+		},
+	})
+}
+
 func testAccModelInvocationLoggingConfiguration_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -326,3 +344,13 @@ resource "aws_bedrock_model_invocation_logging_configuration" "test" {
 }
 `, rName, embeddingDataDeliveryEnabled, imageDataDeliveryEnabled, textDataDeliveryEnabled, videoDataDeliveryEnabled)
 }
+
+func testAccModelInvocationLoggingConfigurationConfig_noDestination() string {
+	return `
+resource "aws_bedrock_model_invocation_logging_configuration" "test" {
+  logging_config {
+    text_data_delivery_enabled = true
+  }
+}
+`
+}
@@ -55,6 +55,7 @@ func newDataSourceResource(_ context.Context) (resource.ResourceWithConfigure, e
 	r := &dataSourceResource{}
 
 	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
 	r.SetDefaultDeleteTimeout(30 * time.Minute)
 
 	return r, nil
@@ -490,6 +491,7 @@ func (r *dataSourceResource) Schema(ctx context.Context, request resource.Schema
 			},
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 			"vector_ingestion_configuration": schema.ListNestedBlock{
@@ -908,6 +910,12 @@ func (r *dataSourceResource) Update(ctx context.Context, request resource.Update
 		return
 	}
 
+	if _, err := waitDataSourceUpdated(ctx, conn, new.DataSourceID.ValueString(), new.KnowledgeBaseID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Data Source (%s) update", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -1004,6 +1012,26 @@ func waitDataSourceCreated(ctx context.Context, conn *bedrockagent.Client, dataS
 	return nil, err
 }
 
+func waitDataSourceUpdated(ctx context.Context, conn *bedrockagent.Client, dataSourceID, knowledgeBaseID string, timeout time.Duration) (*awstypes.DataSource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.DataSourceStatusAvailable),
+		Refresh:                   statusDataSource(ctx, conn, dataSourceID, knowledgeBaseID),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataSource); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDataSourceDeleted(ctx context.Context, conn *bedrockagent.Client, dataSourceID, knowledgeBaseID string, timeout time.Duration) (*awstypes.DataSource, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.DataSourceStatusDeleting),
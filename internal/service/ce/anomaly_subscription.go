@@ -5,7 +5,10 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
+	"strconv"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -43,6 +46,8 @@ func resourceAnomalySubscription() *schema.Resource {
 		UpdateWithoutTimeout: resourceAnomalySubscriptionUpdate,
 		DeleteWithoutTimeout: resourceAnomalySubscriptionDelete,
 
+		CustomizeDiff: validateAnomalySubscriptionThresholdExpression,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrAccountID: {
 				Type:         schema.TypeString,
@@ -290,3 +295,74 @@ func flattenSubscribers(apiObjects []awstypes.Subscriber) []any {
 
 	return tfList
 }
+
+// validateAnomalySubscriptionThresholdExpression checks that threshold_expression only uses the
+// ANOMALY_TOTAL_IMPACT_ABSOLUTE and ANOMALY_TOTAL_IMPACT_PERCENTAGE dimensions, optionally combined with "and" or "or".
+func validateAnomalySubscriptionThresholdExpression(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	v, ok := d.GetOk("threshold_expression")
+	if !ok {
+		return nil
+	}
+
+	tfList := v.([]any)
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+
+	for _, k := range []string{"and", "or"} {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				if err := validateAnomalySubscriptionThresholdDimension(tfMap); err != nil {
+					return fmt.Errorf("threshold_expression.%s: %w", k, err)
+				}
+			}
+
+			return nil
+		}
+	}
+
+	if err := validateAnomalySubscriptionThresholdDimension(tfMap); err != nil {
+		return fmt.Errorf("threshold_expression: %w", err)
+	}
+
+	return nil
+}
+
+func validateAnomalySubscriptionThresholdDimension(tfMap map[string]any) error {
+	for _, k := range []string{"cost_category", "not", names.AttrTags} {
+		if v, ok := tfMap[k].([]any); ok && len(v) > 0 {
+			return fmt.Errorf("%q is not supported in a threshold expression", k)
+		}
+	}
+
+	v, ok := tfMap["dimension"].([]any)
+	if !ok || len(v) == 0 || v[0] == nil {
+		return fmt.Errorf(`"dimension" is required`)
+	}
+
+	tfMap = v[0].(map[string]any)
+
+	// Values may not be known until apply.
+	if key := tfMap[names.AttrKey].(string); key != "" && !slices.Contains([]awstypes.Dimension{awstypes.DimensionAnomalyTotalImpactAbsolute, awstypes.DimensionAnomalyTotalImpactPercentage}, awstypes.Dimension(key)) {
+		return fmt.Errorf("dimension key must be one of %q or %q, got %q", awstypes.DimensionAnomalyTotalImpactAbsolute, awstypes.DimensionAnomalyTotalImpactPercentage, key)
+	}
+
+	if v, ok := tfMap[names.AttrValues].(*schema.Set); ok {
+		for _, v := range v.List() {
+			if v := v.(string); v != "" {
+				if _, err := strconv.ParseFloat(v, 64); err != nil {
+					return fmt.Errorf("dimension value must be a number, got %q", v)
+				}
+			}
+		}
+	}
+
+	return nil
+}
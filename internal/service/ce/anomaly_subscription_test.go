@@ -226,6 +226,50 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_ThresholdExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscription awstypes.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckPayerAccount(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, "LINKED_ACCOUNT", "100"),
+				ExpectError: regexache.MustCompile(`dimension key must be one of`),
+			},
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, string(awstypes.DimensionAnomalyTotalImpactPercentage), "ten"),
+				ExpectError: regexache.MustCompile(`dimension value must be a number`),
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, string(awstypes.DimensionAnomalyTotalImpactPercentage), "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.key", string(awstypes.DimensionAnomalyTotalImpactPercentage)),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.match_options.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.match_options.*", string(awstypes.MatchOptionGreaterThanOrEqual)),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.values.*", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var subscription awstypes.AnomalySubscription
@@ -580,6 +624,32 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName))
 }
 
+func testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, key, value string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = %[3]q
+      values        = [%[4]q]
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+    }
+  }
+}
+`, rName, address, key, value))
+}
+
 func testAccAnomalySubscriptionConfig_tags1(rName, address, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
 resource "aws_ce_anomaly_subscription" "test" {
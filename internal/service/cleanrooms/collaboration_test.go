@@ -194,6 +194,24 @@ func TestAccCleanRoomsCollaboration_updateQueryLogStatus(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCleanRoomsCollaboration_invalidQueryLogStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCollaborationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCollaborationConfig_queryLogStatus(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, "INVALID"),
+				ExpectError: regexache.MustCompile(`expected query_log_status to be one of`),
+			},
+		},
+	})
+}
+
 func TestAccCleanRoomsCollaboration_dataEncryptionSettings(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -20,6 +20,11 @@ const (
 	distributionStatusInProgress = "InProgress"
 )
 
+const (
+	anycastIPListStatusDeployed  = "Deployed"
+	anycastIPListStatusDeploying = "Deploying"
+)
+
 const (
 	keyValueStoreStatusProvisioning = "PROVISIONING"
 	keyValueStoreStatusReady        = "READY"
@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -112,6 +114,9 @@ func (r *continuousDeploymentPolicyResource) Schema(ctx context.Context, request
 								Attributes: map[string]schema.Attribute{
 									names.AttrWeight: schema.Float64Attribute{
 										Required: true,
+										Validators: []validator.Float64{
+											float64validator.Between(0, 0.15),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -124,9 +129,15 @@ func (r *continuousDeploymentPolicyResource) Schema(ctx context.Context, request
 											Attributes: map[string]schema.Attribute{
 												"idle_ttl": schema.Int64Attribute{
 													Required: true,
+													Validators: []validator.Int64{
+														int64validator.Between(300, 3600),
+													},
 												},
 												"maximum_ttl": schema.Int64Attribute{
 													Required: true,
+													Validators: []validator.Int64{
+														int64validator.Between(300, 3600),
+													},
 												},
 											},
 										},
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -207,6 +208,26 @@ func TestAccCloudFrontContinuousDeploymentPolicy_trafficConfig(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontContinuousDeploymentPolicy_invalidWeight(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContinuousDeploymentPolicyConfig_invalidWeight("0.5"),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/33338
 func TestAccCloudFrontContinuousDeploymentPolicy_domainChange(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -558,6 +579,26 @@ resource "aws_cloudfront_continuous_deployment_policy" "test" {
 `, enabled, weight, idleTTL, maxTTL))
 }
 
+func testAccContinuousDeploymentPolicyConfig_invalidWeight(weight string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items    = ["d111111abcdef8.cloudfront.net"]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+    single_weight_config {
+      weight = %[1]q
+    }
+  }
+}
+`, weight)
+}
+
 func testAccContinuousDeploymentPolicyConfig_TrafficConfig_singleHeader(enabled bool, header, value string) string {
 	return acctest.ConfigCompose(
 		testAccContinuousDeploymentPolicyConfigBase_staging(defaultDomain),
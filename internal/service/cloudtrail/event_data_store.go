@@ -5,6 +5,7 @@ package cloudtrail
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -43,6 +45,14 @@ func resourceEventDataStore() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+			if d.Get("federation_enabled").(bool) && d.GetRawConfig().GetAttr("federation_role_arn").IsNull() {
+				return errors.New(`"federation_role_arn" is required when "federation_enabled" is true`)
+			}
+
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"advanced_event_selector": {
 				Type:     schema.TypeList,
@@ -145,6 +155,16 @@ func resourceEventDataStore() *schema.Resource {
 				Default:          types.BillingModeExtendableRetentionPricing,
 				ValidateDiagFunc: enum.Validate[types.BillingMode](),
 			},
+			"federation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"federation_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			names.AttrKMSKeyID: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -231,6 +251,12 @@ func resourceEventDataStoreCreate(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudTrail Event Data Store (%s) create: %s", name, err)
 	}
 
+	if d.Get("federation_enabled").(bool) {
+		if err := enableEventDataStoreFederation(ctx, conn, d.Id(), d.Get("federation_role_arn").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceEventDataStoreRead(ctx, d, meta)...)
 }
 
@@ -256,6 +282,8 @@ func resourceEventDataStoreRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set(names.AttrARN, output.EventDataStoreArn)
 	d.Set(names.AttrKMSKeyID, output.KmsKeyId)
 	d.Set("billing_mode", output.BillingMode)
+	d.Set("federation_enabled", output.FederationStatus == types.FederationStatusEnabled)
+	d.Set("federation_role_arn", output.FederationRoleArn)
 	d.Set("multi_region_enabled", output.MultiRegionEnabled)
 	d.Set(names.AttrName, output.Name)
 	d.Set("organization_enabled", output.OrganizationEnabled)
@@ -269,7 +297,7 @@ func resourceEventDataStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
 
-	if d.HasChangesExcept("suspend", names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("federation_enabled", "federation_role_arn", "suspend", names.AttrTags, names.AttrTagsAll) {
 		input := &cloudtrail.UpdateEventDataStoreInput{
 			EventDataStore: aws.String(d.Id()),
 		}
@@ -313,6 +341,26 @@ func resourceEventDataStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChanges("federation_enabled", "federation_role_arn") {
+		o, n := d.GetChange("federation_enabled")
+		if enabled, wasEnabled := n.(bool), o.(bool); enabled {
+			// The federation role can only be changed by disabling and then re-enabling federation.
+			if wasEnabled {
+				if err := disableEventDataStoreFederation(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+
+			if err := enableEventDataStoreFederation(ctx, conn, d.Id(), d.Get("federation_role_arn").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		} else if wasEnabled {
+			if err := disableEventDataStoreFederation(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	if d.HasChange("suspend") {
 		v := d.Get("suspend")
 		if v, null, _ := nullable.Bool(v.(string)).ValueBool(); !null {
@@ -335,11 +383,28 @@ func resourceEventDataStoreDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudTrailClient(ctx)
 
+	output, err := findEventDataStoreByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudTrail Event Data Store (%s): %s", d.Id(), err)
+	}
+
+	// Lake query federation must be disabled before the event data store can be deleted.
+	if output.FederationStatus == types.FederationStatusEnabled {
+		if err := disableEventDataStoreFederation(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting CloudTrail Event Data Store: %s", d.Id())
 	input := cloudtrail.DeleteEventDataStoreInput{
 		EventDataStore: aws.String(d.Id()),
 	}
-	_, err := conn.DeleteEventDataStore(ctx, &input)
+	_, err = conn.DeleteEventDataStore(ctx, &input)
 
 	if errs.IsA[*types.EventDataStoreNotFoundException](err) {
 		return diags
@@ -414,6 +479,59 @@ func statusEventDataStore(ctx context.Context, conn *cloudtrail.Client, arn stri
 	}
 }
 
+func statusEventDataStoreFederation(ctx context.Context, conn *cloudtrail.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findEventDataStoreByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.FederationStatus), nil
+	}
+}
+
+func enableEventDataStoreFederation(ctx context.Context, conn *cloudtrail.Client, arn, roleARN string, timeout time.Duration) error {
+	input := cloudtrail.EnableFederationInput{
+		EventDataStore:    aws.String(arn),
+		FederationRoleArn: aws.String(roleARN),
+	}
+
+	_, err := conn.EnableFederation(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("enabling CloudTrail Event Data Store (%s) federation: %w", arn, err)
+	}
+
+	if _, err := waitEventDataStoreFederationEnabled(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for CloudTrail Event Data Store (%s) federation enable: %w", arn, err)
+	}
+
+	return nil
+}
+
+func disableEventDataStoreFederation(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) error {
+	input := cloudtrail.DisableFederationInput{
+		EventDataStore: aws.String(arn),
+	}
+
+	_, err := conn.DisableFederation(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("disabling CloudTrail Event Data Store (%s) federation: %w", arn, err)
+	}
+
+	if _, err := waitEventDataStoreFederationDisabled(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for CloudTrail Event Data Store (%s) federation disable: %w", arn, err)
+	}
+
+	return nil
+}
+
 func startEventDataStoreIngestion(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) error {
 	input := cloudtrail.StartEventDataStoreIngestionInput{
 		EventDataStore: aws.String(arn),
@@ -534,3 +652,37 @@ func waitEventDataStoreIngestionStopped(ctx context.Context, conn *cloudtrail.Cl
 
 	return nil, err
 }
+
+func waitEventDataStoreFederationEnabled(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetEventDataStoreOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FederationStatusEnabling),
+		Target:  enum.Slice(types.FederationStatusEnabled),
+		Refresh: statusEventDataStoreFederation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEventDataStoreFederationDisabled(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetEventDataStoreOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FederationStatusDisabling),
+		Target:  enum.Slice(types.FederationStatusDisabled),
+		Refresh: statusEventDataStoreFederation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetEventDataStoreOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -103,6 +103,55 @@ func TestAccCloudTrailEventDataStore_billingMode(t *testing.T) {
 	})
 }
 
+func TestAccCloudTrailEventDataStore_federation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_event_data_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDataStoreConfig_federation(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "federation_role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"suspend"},
+			},
+			{
+				Config: testAccEventDataStoreConfig_federation(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				// Leave federation enabled so that the event data store is destroyed with federation still on.
+				Config: testAccEventDataStoreConfig_federation(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudTrailEventDataStore_suspend(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -486,6 +535,42 @@ resource "aws_cloudtrail_event_data_store" "test" {
 `, rName, suspend)
 }
 
+func testAccEventDataStoreConfig_federation(rName string, federationEnabled bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudtrail.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AWSGlueConsoleFullAccess"
+}
+
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  federation_enabled             = %[2]t
+  federation_role_arn            = aws_iam_role.test.arn
+  termination_protection_enabled = false # For ease of deletion.
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, federationEnabled)
+}
+
 func testAccEventDataStoreConfig_billingModeUpdated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudtrail_event_data_store" "test" {
@@ -15,6 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -32,7 +35,7 @@ import (
 // @Tags(identifierAttribute="resource_arn")
 // @Testing(importStateIdFunc="testAccContributorInsightRuleImportStateIDFunc")
 // @Testing(importStateIdAttribute="rule_name")
-// @Testing(importIgnore="rule_definition;rule_state")
+// @Testing(importIgnore="rule_definition")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatch/types;types.InsightRule")
 func newContributorInsightRuleResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &contributorInsightRuleResource{}
@@ -57,10 +60,15 @@ func (r *contributorInsightRuleResource) Schema(ctx context.Context, req resourc
 			},
 			"rule_name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"rule_state": schema.StringAttribute{
 				Optional:   true,
+				Computed:   true,
 				CustomType: fwtypes.StringEnumType[stateValue](),
+				Default:    stringdefault.StaticString(string(stateValueEnabled)),
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
@@ -141,6 +149,13 @@ func (r *contributorInsightRuleResource) Read(ctx context.Context, req resource.
 	state.ResourceARN = fwflex.StringValueToFramework(ctx, cirARN)
 
 	smerr.EnrichAppend(ctx, &resp.Diagnostics, fwflex.Flatten(ctx, out, &state), smerr.ID, state.RuleName.String())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API's field names don't match the schema's, so AutoFlEx can't map them.
+	state.RuleState = fwtypes.StringEnumValue(stateValue(aws.ToString(out.State)))
+
 	smerr.EnrichAppend(ctx, &resp.Diagnostics, resp.State.Set(ctx, &state), smerr.ID, state.RuleName.String())
 }
 
@@ -158,7 +173,19 @@ func (r *contributorInsightRuleResource) Update(ctx context.Context, req resourc
 
 	conn := r.Meta().CloudWatchClient(ctx)
 
-	if !new.RuleState.IsNull() && !old.RuleState.Equal(new.RuleState) {
+	if !old.RuleDefinition.Equal(new.RuleDefinition) {
+		// PutInsightRule overwrites the existing rule's definition and state.
+		input := cloudwatch.PutInsightRuleInput{
+			RuleDefinition: new.RuleDefinition.ValueStringPointer(),
+			RuleName:       new.RuleName.ValueStringPointer(),
+			RuleState:      new.RuleState.ValueStringPointer(),
+		}
+		_, err := conn.PutInsightRule(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &resp.Diagnostics, err, smerr.ID, new.RuleName.String())
+			return
+		}
+	} else if !new.RuleState.IsNull() && !old.RuleState.Equal(new.RuleState) {
 		if new.RuleState.ValueEnum() == stateValueEnabled {
 			input := cloudwatch.EnableInsightRulesInput{
 				RuleNames: []string{new.RuleName.ValueString()},
@@ -166,6 +193,7 @@ func (r *contributorInsightRuleResource) Update(ctx context.Context, req resourc
 			_, err := conn.EnableInsightRules(ctx, &input)
 			if err != nil {
 				smerr.AddError(ctx, &resp.Diagnostics, err, smerr.ID, new.RuleName.String())
+				return
 			}
 		} else if new.RuleState.ValueEnum() == stateValueDisabled {
 			input := cloudwatch.DisableInsightRulesInput{
@@ -174,6 +202,7 @@ func (r *contributorInsightRuleResource) Update(ctx context.Context, req resourc
 			_, err := conn.DisableInsightRules(ctx, &input)
 			if err != nil {
 				smerr.AddError(ctx, &resp.Diagnostics, err, smerr.ID, new.RuleName.String())
+				return
 			}
 		}
 	}
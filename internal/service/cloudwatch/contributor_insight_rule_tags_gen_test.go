@@ -74,7 +74,7 @@ func TestAccCloudWatchContributorInsightRule_tags(t *testing.T) {
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -128,7 +128,7 @@ func TestAccCloudWatchContributorInsightRule_tags(t *testing.T) {
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -176,7 +176,7 @@ func TestAccCloudWatchContributorInsightRule_tags(t *testing.T) {
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -212,7 +212,7 @@ func TestAccCloudWatchContributorInsightRule_tags(t *testing.T) {
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -278,7 +278,7 @@ func TestAccCloudWatchContributorInsightRule_tags_null(t *testing.T) {
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
 					acctest.CtTagsKey1, // The canonical value returned by the AWS API is ""
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -332,7 +332,7 @@ func TestAccCloudWatchContributorInsightRule_tags_EmptyMap(t *testing.T) {
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
 					acctest.CtTagsKey1, // The canonical value returned by the AWS API is ""
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -418,7 +418,7 @@ func TestAccCloudWatchContributorInsightRule_tags_AddOnUpdate(t *testing.T) {
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -483,7 +483,7 @@ func TestAccCloudWatchContributorInsightRule_tags_EmptyTag_OnCreate(t *testing.T
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -519,7 +519,7 @@ func TestAccCloudWatchContributorInsightRule_tags_EmptyTag_OnCreate(t *testing.T
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -621,7 +621,7 @@ func TestAccCloudWatchContributorInsightRule_tags_EmptyTag_OnUpdate_Add(t *testi
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -669,7 +669,7 @@ func TestAccCloudWatchContributorInsightRule_tags_EmptyTag_OnUpdate_Add(t *testi
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -765,7 +765,7 @@ func TestAccCloudWatchContributorInsightRule_tags_EmptyTag_OnUpdate_Replace(t *t
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -829,7 +829,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_providerOnly(t *te
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -881,7 +881,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_providerOnly(t *te
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -929,7 +929,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_providerOnly(t *te
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -967,7 +967,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_providerOnly(t *te
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1041,7 +1041,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_nonOverlapping(t *
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -1105,7 +1105,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_nonOverlapping(t *
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -1143,7 +1143,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_nonOverlapping(t *
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1215,7 +1215,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_overlapping(t *tes
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -1279,7 +1279,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_overlapping(t *tes
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 			{
@@ -1335,7 +1335,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_overlapping(t *tes
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1431,7 +1431,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_updateToProviderOn
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1526,7 +1526,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_updateToResourceOn
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1598,7 +1598,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_emptyResourceTag(t
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1662,7 +1662,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_emptyProviderOnlyT
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1735,7 +1735,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_nullOverlappingRes
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
 					acctest.CtTagsKey1, // The canonical value returned by the AWS API is ""
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1810,7 +1810,7 @@ func TestAccCloudWatchContributorInsightRule_tags_DefaultTags_nullNonOverlapping
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
 					"tags.resourcekey1", // The canonical value returned by the AWS API is ""
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1871,7 +1871,7 @@ func TestAccCloudWatchContributorInsightRule_tags_ComputedTag_OnCreate(t *testin
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -1974,7 +1974,7 @@ func TestAccCloudWatchContributorInsightRule_tags_ComputedTag_OnUpdate_Add(t *te
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -2067,7 +2067,7 @@ func TestAccCloudWatchContributorInsightRule_tags_ComputedTag_OnUpdate_Replace(t
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
-					"rule_definition", "rule_state",
+					"rule_definition",
 				},
 			},
 		},
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -52,13 +53,113 @@ func TestAccCloudWatchContributorInsightRule_basic(t *testing.T) {
 				ImportStateVerifyIdentifierAttribute: "rule_name",
 				ImportStateVerifyIgnore: []string{
 					"rule_definition",
-					"rule_state",
 				},
 			},
 		},
 	})
 }
 
+func TestAccCloudWatchContributorInsightRule_ruleState(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v types.InsightRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudWatchEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "ENABLED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "DISABLED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccContributorInsightRuleImportStateIDFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "rule_name",
+				ImportStateVerifyIgnore: []string{
+					"rule_definition",
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchContributorInsightRule_ruleDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v types.InsightRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudWatchEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_ruleDefinition(rName, "some-keyword"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_definition", "{\"Schema\":{\"Name\":\"CloudWatchLogRule\",\"Version\":1},\"AggregateOn\":\"Count\",\"Contribution\":{\"Filters\":[{\"In\":[\"some-keyword\"],\"Match\":\"$.message\"}],\"Keys\":[\"$.country\"]},\"LogFormat\":\"JSON\",\"LogGroupNames\":[\"/aws/lambda/api-prod\"]}"),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_ruleDefinition(rName, "other-keyword"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_definition", "{\"Schema\":{\"Name\":\"CloudWatchLogRule\",\"Version\":1},\"AggregateOn\":\"Count\",\"Contribution\":{\"Filters\":[{\"In\":[\"other-keyword\"],\"Match\":\"$.message\"}],\"Keys\":[\"$.country\"]},\"LogFormat\":\"JSON\",\"LogGroupNames\":[\"/aws/lambda/api-prod\"]}"),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchContributorInsightRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -158,3 +259,13 @@ resource "aws_cloudwatch_contributor_insight_rule" "test" {
 }
 `, rName, state)
 }
+
+func testAccContributorInsightRuleConfig_ruleDefinition(rName, keyword string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name       = %[1]q
+  rule_state      = "ENABLED"
+  rule_definition = "{\"Schema\":{\"Name\":\"CloudWatchLogRule\",\"Version\":1},\"AggregateOn\":\"Count\",\"Contribution\":{\"Filters\":[{\"In\":[\"%[2]s\"],\"Match\":\"$.message\"}],\"Keys\":[\"$.country\"]},\"LogFormat\":\"JSON\",\"LogGroupNames\":[\"/aws/lambda/api-prod\"]}"
+}
+`, rName, keyword)
+}
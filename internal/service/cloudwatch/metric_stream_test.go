@@ -411,6 +411,28 @@ func TestAccCloudWatchMetricStream_additional_statistics(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_invalidRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricStreamConfig_roleARN(rName, "arn:aws:iam::123456789012:user/David"), // lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`expected resource type to be one of \["role"\]`),
+			},
+			{
+				Config:      testAccMetricStreamConfig_roleARN(rName, "arn:aws:sts::123456789012:assumed-role/David/session"), // lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`expected service "iam"`),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_includeLinkedAccountsMetrics(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cloudwatch_metric_stream.test"
@@ -600,6 +622,17 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName))
 }
 
+func testAccMetricStreamConfig_roleARN(rName, roleARN string) string {
+	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = %[2]q
+  firehose_arn  = aws_kinesis_firehose_delivery_stream.s3_stream.arn
+  output_format = "json"
+}
+`, rName, roleARN))
+}
+
 func testAccMetricStreamConfig_nameGenerated(rName string) string {
 	return acctest.ConfigCompose(testAccMetricStreamConfig_base(rName), `
 resource "aws_cloudwatch_metric_stream" "test" {
@@ -82,6 +82,10 @@ func resourceLandingZone() *schema.Resource {
 					return json
 				},
 			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -124,6 +128,10 @@ func resourceLandingZoneCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) create: %s", d.Id(), err)
 	}
 
+	if _, err := waitLandingZoneActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) active: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceLandingZoneRead(ctx, d, meta)...)
 }
 
@@ -163,6 +171,7 @@ func resourceLandingZoneRead(ctx context.Context, d *schema.ResourceData, meta a
 	} else {
 		d.Set("manifest_json", nil)
 	}
+	d.Set(names.AttrStatus, landingZone.Status)
 	d.Set(names.AttrVersion, landingZone.Version)
 
 	return diags
@@ -193,6 +202,10 @@ func resourceLandingZoneUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) update: %s", d.Id(), err)
 		}
+
+		if _, err := waitLandingZoneActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) active: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceLandingZoneRead(ctx, d, meta)...)
@@ -208,6 +221,10 @@ func resourceLandingZoneDelete(ctx context.Context, d *schema.ResourceData, meta
 	}
 	output, err := conn.DeleteLandingZone(ctx, &input)
 
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting ControlTower Landing Zone: %s", err)
 	}
@@ -316,6 +333,39 @@ func waitLandingZoneOperationSucceeded(ctx context.Context, conn *controltower.C
 	return nil, err
 }
 
+func statusLandingZone(ctx context.Context, conn *controltower.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findLandingZoneByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitLandingZoneActive(ctx context.Context, conn *controltower.Client, id string, timeout time.Duration) (*types.LandingZoneDetail, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.LandingZoneStatusProcessing),
+		Target:  enum.Slice(types.LandingZoneStatusActive),
+		Refresh: statusLandingZone(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.LandingZoneDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func flattenLandingZoneDriftStatusSummary(apiObject *types.LandingZoneDriftStatusSummary) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -68,6 +68,10 @@ func ResourceMember() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"invitation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"invited_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -161,6 +165,7 @@ func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set("disabled_reason", member.DisabledReason)
 	d.Set("email_address", member.EmailAddress)
 	d.Set("graph_arn", member.GraphArn)
+	d.Set("invitation_type", member.InvitationType)
 	d.Set("invited_time", aws.ToTime(member.InvitedTime).Format(time.RFC3339))
 	d.Set(names.AttrStatus, member.Status)
 	d.Set("updated_time", aws.ToTime(member.UpdatedTime).Format(time.RFC3339))
@@ -287,7 +292,7 @@ func waitMemberInvited(ctx context.Context, conn *detective.Client, graphARN, ad
 	)
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.MemberStatusVerificationInProgress),
-		Target:  enum.Slice(awstypes.MemberStatusInvited, awstypes.MemberStatusEnabled),
+		Target:  enum.Slice(awstypes.MemberStatusInvited, awstypes.MemberStatusEnabled, awstypes.MemberStatusAcceptedButDisabled),
 		Refresh: statusMember(ctx, conn, graphARN, adminAccountID),
 		Timeout: timeout,
 	}
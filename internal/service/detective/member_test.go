@@ -142,6 +142,7 @@ func testAccMember_Organization_basic(t *testing.T) {
 					acctest.CheckResourceAttrAccountID(ctx, resourceName, "administrator_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "email_address", "data.aws_organizations_organization.test", "non_master_accounts.0.email"),
 					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "graph_arn"),
+					resource.TestCheckResourceAttr(resourceName, "invitation_type", string(awstypes.InvitationTypeOrganization)),
 					acctest.CheckResourceAttrRFC3339(resourceName, "invited_time"),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrMessage),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.MemberStatusEnabled)),
@@ -184,6 +185,7 @@ func testAccMember_Organization_AutoEnable(t *testing.T) {
 					acctest.CheckResourceAttrAccountID(ctx, resourceName, "administrator_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "email_address", "data.aws_organizations_organization.test", "non_master_accounts.0.email"),
 					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "graph_arn"),
+					resource.TestCheckResourceAttr(resourceName, "invitation_type", string(awstypes.InvitationTypeOrganization)),
 					acctest.CheckResourceAttrRFC3339(resourceName, "invited_time"),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrMessage),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.MemberStatusEnabled)),
@@ -5,13 +5,18 @@ package detective
 
 import (
 	"context"
+	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/detective"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/detective/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -71,11 +76,13 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	conn := meta.(*conns.AWSClient).DetectiveClient(ctx)
 
-	input := &detective.DescribeOrganizationConfigurationInput{
-		GraphArn: aws.String(d.Id()),
-	}
+	output, err := findOrganizationConfigurationByGraphARN(ctx, conn, d.Id())
 
-	output, err := conn.DescribeOrganizationConfiguration(ctx, input)
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Organization Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Detective Organization Configuration (%s): %s", d.Id(), err)
@@ -86,3 +93,28 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	return diags
 }
+
+func findOrganizationConfigurationByGraphARN(ctx context.Context, conn *detective.Client, graphARN string) (*detective.DescribeOrganizationConfigurationOutput, error) {
+	input := &detective.DescribeOrganizationConfigurationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	output, err := conn.DescribeOrganizationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -168,7 +168,7 @@ func resourceConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed:     true,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"no_encrypt", "should_encrypt", "must_encrypt"}, false),
+				ValidateFunc: validation.StringInSlice(connectionEncryptionMode_Values(), false),
 			},
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
@@ -188,6 +188,31 @@ func resourceConnection() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			// The MAC Security (MACsec) security keys associated with the connection.
+			"macsec_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ckn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			// Enable or disable MAC Security (MACsec) on this connection.
 			"request_macsec": {
 				Type:     schema.TypeBool,
@@ -230,6 +255,8 @@ func resourceConnection() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceConnectionCustomizeDiff,
 	}
 }
 
@@ -292,6 +319,9 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set("jumbo_frame_capable", connection.JumboFrameCapable)
 	d.Set(names.AttrLocation, connection.Location)
 	d.Set("macsec_capable", connection.MacSecCapable)
+	if err := d.Set("macsec_keys", flattenMacSecKeys(connection.MacSecKeys)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting macsec_keys: %s", err)
+	}
 	d.Set(names.AttrName, connection.ConnectionName)
 	d.Set(names.AttrOwnerAccountID, connection.OwnerAccount)
 	d.Set("partner_name", connection.PartnerName)
@@ -329,6 +359,17 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return append(diags, resourceConnectionRead(ctx, d, meta)...)
 }
 
+func resourceConnectionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" {
+		// New resource.
+		if encryptionMode := diff.Get("encryption_mode").(string); encryptionMode != "" && encryptionMode != connectionEncryptionModeNoEncrypt && !diff.Get("request_macsec").(bool) {
+			return fmt.Errorf("'request_macsec' must be true when 'encryption_mode' is '%s'", encryptionMode)
+		}
+	}
+
+	return nil
+}
+
 func resourceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DirectConnectClient(ctx)
@@ -452,3 +493,22 @@ func waitConnectionDeleted(ctx context.Context, conn *directconnect.Client, id s
 
 	return nil, err
 }
+
+func flattenMacSecKeys(apiObjects []awstypes.MacSecKey) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"ckn":           aws.ToString(apiObject.Ckn),
+			"secret_arn":    aws.ToString(apiObject.SecretARN),
+			"start_on":      aws.ToString(apiObject.StartOn),
+			names.AttrState: aws.ToString(apiObject.State),
+		})
+	}
+
+	return tfList
+}
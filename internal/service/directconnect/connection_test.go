@@ -126,6 +126,35 @@ func TestAccDirectConnectConnection_encryptionMode(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
 				),
 			},
+			{
+				// Refresh to pick up the MACsec key associated after the connection was updated.
+				Config: testAccConnectionConfig_encryptionModeShouldEncrypt(connectionName, ckn, cak),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "encryption_mode", "should_encrypt"),
+					resource.TestCheckResourceAttr(resourceName, "macsec_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "macsec_keys.0.ckn", ckn),
+					resource.TestCheckResourceAttrPair(resourceName, "macsec_keys.0.secret_arn", "aws_dx_macsec_key_association.test", "secret_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDirectConnectConnection_encryptionModeWithoutMACsec(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionConfig_encryptionModeWithoutMACsec(rName),
+				ExpectError: regexache.MustCompile(`'request_macsec' must be true when 'encryption_mode' is 'should_encrypt'`),
+			},
 		},
 	})
 }
@@ -443,6 +472,18 @@ resource "aws_dx_connection" "test" {
 `, rName)
 }
 
+func testAccConnectionConfig_encryptionModeWithoutMACsec(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_connection" "test" {
+  name            = %[1]q
+  location        = "CSOW"
+  bandwidth       = "100Gbps"
+  encryption_mode = "should_encrypt"
+  request_macsec  = false
+}
+`, rName)
+}
+
 func testAccConnectionConfig_encryptionModeShouldEncrypt(rName, ckn, cak string) string {
 	return fmt.Sprintf(`
 resource "aws_dx_connection" "test" {
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect
+
+const (
+	connectionEncryptionModeMustEncrypt   = "must_encrypt"
+	connectionEncryptionModeNoEncrypt     = "no_encrypt"
+	connectionEncryptionModeShouldEncrypt = "should_encrypt"
+)
+
+func connectionEncryptionMode_Values() []string {
+	return []string{
+		connectionEncryptionModeMustEncrypt,
+		connectionEncryptionModeNoEncrypt,
+		connectionEncryptionModeShouldEncrypt,
+	}
+}
@@ -120,6 +120,9 @@ func (r *clusterResource) Schema(ctx context.Context, request resource.SchemaReq
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.RequiresReplace(),
 							},
+							Validators: []validator.String{
+								fwvalidators.AWSRegion(),
+							},
 						},
 					},
 				},
@@ -254,6 +254,26 @@ func TestAccDSQLCluster_encryption(t *testing.T) {
 	})
 }
 
+func TestAccDSQLCluster_invalidWitnessRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_witnessRegion("not-a-region"),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DSQLClient(ctx)
@@ -322,6 +342,16 @@ resource "aws_dsql_cluster" "test" {
 `
 }
 
+func testAccClusterConfig_witnessRegion(witnessRegion string) string {
+	return fmt.Sprintf(`
+resource "aws_dsql_cluster" "test" {
+  multi_region_properties {
+    witness_region = %[1]q
+  }
+}
+`, witnessRegion)
+}
+
 func testAccClusterConfig_deletionProtection(deletionProtection bool) string {
 	return fmt.Sprintf(`
 resource "aws_dsql_cluster" "test" {
@@ -71,6 +71,9 @@ func resourceTable() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, meta any) error {
 				return validateTableAttributes(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+				return validReplicaConsistencyMode(diff)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, meta any) error {
 				if diff.Id() != "" && diff.HasChange("server_side_encryption") {
 					o, n := diff.GetChange("server_side_encryption")
@@ -1476,35 +1479,13 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 	// then the update table action will fail with
 	// "Unsupported table replica count for global tables with MultiRegionConsistency set to STRONG"
 	// If this logic can be consolidated for regular Replica creation then this can be refactored
-	numReplicas := len(tfList)
-	numReplicasMRSC := 0
-	useMRSC := false
-	mrscInput := awstypes.MultiRegionConsistencyStrong
-
-	for _, tfMapRaw := range tfList {
-		tfMap, _ := tfMapRaw.(map[string]any)
+	useMRSC, err := replicasUseMRSC(tfList)
 
-		if v, ok := tfMap["consistency_mode"].(string); ok {
-			if awstypes.MultiRegionConsistency(v) == awstypes.MultiRegionConsistencyStrong {
-				numReplicasMRSC += 1
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("creating replicas: %w", err)
 	}
 
-	if numReplicasMRSC > 0 {
-		if numReplicasMRSC > 0 && numReplicasMRSC != numReplicas {
-			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency requires all replicas to use 'consistency_mode' set to 'STRONG' ")
-		}
-		if numReplicasMRSC == 1 {
-			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency requires exactly 2 replicas. ")
-		}
-		if numReplicasMRSC > 2 {
-			return fmt.Errorf("creating replicas: Using MultiRegionStrongConsistency supports at most 2 replicas. ")
-		}
-
-		mrscInput = awstypes.MultiRegionConsistencyStrong
-		useMRSC = true
-	}
+	mrscInput := awstypes.MultiRegionConsistencyStrong
 
 	// if MRSC or MREC is defined and meets the above criteria, then all replicas must be created in a single call to UpdateTable.
 	if useMRSC {
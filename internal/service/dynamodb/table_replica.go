@@ -77,7 +77,7 @@ func resourceTableReplica() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidKMSKeyARN,
 			},
 			"point_in_time_recovery": { // direct to replica
 				Type:     schema.TypeBool,
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,6 +40,51 @@ func validStreamSpec(d *schema.ResourceDiff) error {
 	return nil
 }
 
+// validReplicaConsistencyMode checks the replica count requirements for multi-Region strong consistency (MRSC)
+// so that misconfigurations are reported at plan time rather than after the table has been created.
+func validReplicaConsistencyMode(d *schema.ResourceDiff) error {
+	v, ok := d.Get("replica").(*schema.Set)
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	_, err := replicasUseMRSC(v.List())
+
+	return err
+}
+
+// replicasUseMRSC reports whether the replicas are configured for multi-Region strong consistency (MRSC),
+// returning an error if the MRSC replica count requirements are not met.
+func replicasUseMRSC(tfList []any) (bool, error) {
+	numReplicas := len(tfList)
+	numReplicasMRSC := 0
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["consistency_mode"].(string); ok && awstypes.MultiRegionConsistency(v) == awstypes.MultiRegionConsistencyStrong {
+			numReplicasMRSC++
+		}
+	}
+
+	if numReplicasMRSC == 0 {
+		return false, nil
+	}
+	if numReplicasMRSC != numReplicas {
+		return false, errors.New("Using MultiRegionStrongConsistency requires all replicas to use 'consistency_mode' set to 'STRONG'")
+	}
+	if numReplicasMRSC == 1 {
+		return false, errors.New("Using MultiRegionStrongConsistency requires exactly 2 replicas")
+	}
+	if numReplicasMRSC > 2 {
+		return false, errors.New("Using MultiRegionStrongConsistency supports at most 2 replicas")
+	}
+
+	return true, nil
+}
+
 // checkIfNonKeyAttributesChanged returns true if non_key_attributes between old map and new map are different
 func checkIfNonKeyAttributesChanged(oldMap, newMap map[string]any) bool {
 	oldNonKeyAttributes, oldNkaExists := oldMap["non_key_attributes"].(*schema.Set)
@@ -124,6 +124,7 @@ func resourceEBSVolume() *schema.Resource {
 			"volume_initialization_rate": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(100, 300),
 			},
 		},
@@ -14,6 +14,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -889,7 +890,7 @@ func TestAccEC2EBSVolume_snapshotIDAndVolumeInitializationRate(t *testing.T) {
 		CheckDestroy:             testAccCheckVolumeDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccEBSVolumeConfig_snapshotIdAndVolumeInitializationRate(rName),
+				Config: testAccEBSVolumeConfig_snapshotIdAndVolumeInitializationRate(rName, 100),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVolumeExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`volume/vol-.+`)),
@@ -912,6 +913,18 @@ func TestAccEC2EBSVolume_snapshotIDAndVolumeInitializationRate(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"final_snapshot"},
 			},
+			{
+				Config: testAccEBSVolumeConfig_snapshotIdAndVolumeInitializationRate(rName, 200),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "volume_initialization_rate", "200"),
+				),
+			},
 		},
 	})
 }
@@ -1476,7 +1489,7 @@ resource "aws_ebs_volume" "test" {
 `, rName, size))
 }
 
-func testAccEBSVolumeConfig_snapshotIdAndVolumeInitializationRate(rName string) string {
+func testAccEBSVolumeConfig_snapshotIdAndVolumeInitializationRate(rName string, rate int) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
 		fmt.Sprintf(`
@@ -1501,13 +1514,13 @@ resource "aws_ebs_volume" "test" {
   availability_zone = data.aws_availability_zones.available.names[0]
   snapshot_id       = aws_ebs_snapshot.test.id
 
-  volume_initialization_rate = 100
+  volume_initialization_rate = %[2]d
 
   tags = {
     Name = %[1]q
   }
 }
-`, rName))
+`, rName, rate))
 }
 
 var testAccEBSVolumeConfig_volumeInitializationRateWithoutSnapshotId = acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
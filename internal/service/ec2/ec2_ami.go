@@ -464,12 +464,21 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return sdkdiag.AppendErrorf(diags, "setting ephemeral_block_device: %s", err)
 	}
 
-	input := ec2.GetInstanceUefiDataInput{
-		InstanceId: aws.String(d.Id()),
-	}
-	instanceData, err := conn.GetInstanceUefiData(ctx, &input)
-	if err == nil {
-		d.Set("uefi_data", instanceData.UefiData)
+	// UEFI data is only available for images that boot using UEFI.
+	if bootMode := image.BootMode; bootMode == awstypes.BootModeValuesUefi || bootMode == awstypes.BootModeValuesUefiPreferred {
+		uefiData, err := findImageUEFIDataByID(ctx, conn, d.Id())
+
+		switch {
+		// Callers without ec2:DescribeImageAttribute permission, or reading an image they don't own, can't read UEFI data.
+		case tfawserr.ErrCodeEquals(err, errCodeAuthFailure, errCodeUnauthorizedOperation):
+			log.Printf("[WARN] Unable to read EC2 AMI (%s) UEFI data: %s", d.Id(), err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading EC2 AMI (%s) UEFI data: %s", d.Id(), err)
+		default:
+			d.Set("uefi_data", uefiData)
+		}
+	} else {
+		d.Set("uefi_data", nil)
 	}
 
 	setTagsOut(ctx, image.Tags)
@@ -343,12 +343,11 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set("usage_operation", image.UsageOperation)
 	d.Set("virtualization_type", image.VirtualizationType)
 
-	getInstanceUEFIDataInput := ec2.GetInstanceUefiDataInput{
-		InstanceId: aws.String(d.Id()),
-	}
-	instanceData, err := conn.GetInstanceUefiData(ctx, &getInstanceUEFIDataInput)
-	if err == nil {
-		d.Set("uefi_data", instanceData.UefiData)
+	// UEFI data is only available for images that boot using UEFI and can only be read by the image owner, so ignore any error.
+	if bootMode := image.BootMode; bootMode == awstypes.BootModeValuesUefi || bootMode == awstypes.BootModeValuesUefiPreferred {
+		if uefiData, err := findImageUEFIDataByID(ctx, conn, d.Id()); err == nil {
+			d.Set("uefi_data", uefiData)
+		}
 	}
 
 	setTagsOut(ctx, image.Tags)
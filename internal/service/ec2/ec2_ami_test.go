@@ -478,7 +478,9 @@ func TestAccEC2AMI_tpmSupport(t *testing.T) {
 				Config: testAccAMIConfig_tpmSupport(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAMIExists(ctx, resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "boot_mode", "uefi"),
 					resource.TestCheckResourceAttr(resourceName, "tpm_support", "v2.0"),
+					resource.TestCheckResourceAttr(resourceName, "uefi_data", ""),
 				),
 			},
 			{
@@ -179,7 +179,13 @@ func (r *capacityBlockReservationResource) Create(ctx context.Context, request r
 	output, err := conn.PurchaseCapacityBlock(ctx, &input)
 
 	if err != nil {
-		response.Diagnostics.AddError("purchasing EC2 Capacity Block Reservation", err.Error())
+		response.Diagnostics.AddError(fmt.Sprintf("purchasing EC2 Capacity Block Reservation (%s)", data.CapacityBlockOfferingID.ValueString()), err.Error())
+
+		return
+	}
+
+	if output.CapacityReservation == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("purchasing EC2 Capacity Block Reservation (%s)", data.CapacityBlockOfferingID.ValueString()), "empty result")
 
 		return
 	}
@@ -214,7 +220,7 @@ func (r *capacityBlockReservationResource) Read(ctx context.Context, request res
 
 	conn := r.Meta().EC2Client(ctx)
 
-	cr, err := findCapacityReservationByID(ctx, conn, data.ID.ValueString())
+	cr, err := findCapacityBlockReservationByID(ctx, conn, data.ID.ValueString())
 
 	if tfresource.NotFound(err) {
 		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
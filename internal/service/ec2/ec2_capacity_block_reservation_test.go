@@ -23,8 +23,7 @@ import (
 func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	key := "RUN_EC2_CAPACITY_BLOCK_RESERVATION_TESTS"
-	vifId := os.Getenv(key)
-	if vifId != acctest.CtTrue {
+	if os.Getenv(key) != acctest.CtTrue {
 		t.Skipf("Environment variable %s is not set to true", key)
 	}
 
@@ -44,17 +43,25 @@ func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
 				Config: testAccCapacityBlockReservationConfig_basic(startDate, endDate),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCapacityBlockReservationExists(ctx, resourceName, &reservation),
-					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`capacity-reservation/cr-:.+`)),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`capacity-reservation/cr-.+`)),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAvailabilityZone, resourceName, names.AttrAvailabilityZone),
 					resource.TestCheckResourceAttrPair(dataSourceName, "capacity_block_offering_id", resourceName, "capacity_block_offering_id"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "start_date", resourceName, "start_date"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "end_date", resourceName, "end_date"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrInstanceCount, resourceName, names.AttrInstanceCount),
-					resource.TestCheckResourceAttrPair(dataSourceName, "instance_platform", resourceName, "instance_platform"),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", string(awstypes.CapacityReservationInstancePlatformLinuxUnix)),
+					resource.TestCheckResourceAttr(resourceName, "reservation_type", string(awstypes.CapacityReservationTypeCapacityBlock)),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrInstanceType, resourceName, names.AttrInstanceType),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tenancy", resourceName, "tenancy"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"capacity_block_offering_id"},
+			},
 		},
 	})
 }
@@ -83,15 +90,15 @@ func testAccCheckCapacityBlockReservationExists(ctx context.Context, n string, v
 func testAccCapacityBlockReservationConfig_basic(startDate, endDate string) string {
 	return fmt.Sprintf(`
 data "aws_ec2_capacity_block_offering" "test" {
-  instance_type     = "p4d.24xlarge"
-  capacity_duration = 24
-  instance_count    = 1
-  start_date        = %[1]q
-  end_date          = %[2]q
+  instance_type           = "p4d.24xlarge"
+  capacity_duration_hours = 24
+  instance_count          = 1
+  start_date_range        = %[1]q
+  end_date_range          = %[2]q
 }
 
 resource "aws_ec2_capacity_block_reservation" "test" {
-  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.id
+  capacity_block_offering_id = data.aws_ec2_capacity_block_offering.test.capacity_block_offering_id
   instance_platform          = "Linux/UNIX"
   tags = {
     "Environment" = "dev"
@@ -34,10 +34,11 @@ func resourceImageBlockPublicAccess() *schema.Resource {
 		CreateWithoutTimeout: resourceImageBlockPublicAccessPut,
 		ReadWithoutTimeout:   resourceImageBlockPublicAccessRead,
 		UpdateWithoutTimeout: resourceImageBlockPublicAccessPut,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceImageBlockPublicAccessDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,6 +109,25 @@ func resourceImageBlockPublicAccessRead(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourceImageBlockPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Removing the resource disables blocking of public AMI sharing.
+	input := ec2.DisableImageBlockPublicAccessInput{}
+	_, err := conn.DisableImageBlockPublicAccess(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EC2 Image Block Public Access: %s", err)
+	}
+
+	if err := waitImageBlockPublicAccessState(ctx, conn, string(awstypes.ImageBlockPublicAccessDisabledStateUnblocked), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Image Block Public Access state (%s): %s", awstypes.ImageBlockPublicAccessDisabledStateUnblocked, err)
+	}
+
+	return diags
+}
+
 func imageBlockPublicAccessDisabledState_Values() []string {
 	return enum.Values[awstypes.ImageBlockPublicAccessDisabledState]()
 }
@@ -4,11 +4,17 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,7 +36,7 @@ func testAccImageBlockPublicAccess_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             acctest.CheckDestroyNoop,
+		CheckDestroy:             testAccCheckImageBlockPublicAccessDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccImageBlockPublicAccessConfig_basic("unblocked"),
@@ -50,6 +56,24 @@ func testAccImageBlockPublicAccess_basic(t *testing.T) {
 	})
 }
 
+func testAccCheckImageBlockPublicAccessDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindImageBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if state := aws.ToString(output); state != string(awstypes.ImageBlockPublicAccessDisabledStateUnblocked) {
+			return fmt.Errorf("EC2 Image Block Public Access is not in expected state (%s), got %s", awstypes.ImageBlockPublicAccessDisabledStateUnblocked, state)
+		}
+
+		return nil
+	}
+}
+
 func testAccImageBlockPublicAccessConfig_basic(state string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_image_block_public_access" "test" {
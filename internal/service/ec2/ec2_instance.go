@@ -848,6 +848,10 @@ func resourceInstance() *schema.Resource {
 				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.Tenancy](),
 			},
+			"tpm_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"user_data": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -3283,6 +3287,7 @@ func resourceInstanceFlatten(ctx context.Context, client *conns.AWSClient, insta
 	}
 
 	rd.Set("ebs_optimized", instance.EbsOptimized)
+	rd.Set("tpm_support", instance.TpmSupport)
 	if aws.ToString(instance.SubnetId) != "" {
 		rd.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -6051,6 +6051,7 @@ func TestAccEC2Instance_enclaveOptions(t *testing.T) {
 					testAccCheckInstanceExists(ctx, resourceName, &v1),
 					resource.TestCheckResourceAttr(resourceName, "enclave_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "enclave_options.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tpm_support", ""),
 				),
 			},
 			{
@@ -6061,6 +6062,11 @@ func TestAccEC2Instance_enclaveOptions(t *testing.T) {
 			},
 			{
 				Config: testAccInstanceConfig_enclaveOptions(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckInstanceExists(ctx, resourceName, &v2),
 					testAccCheckInstanceRecreated(&v1, &v2),
@@ -183,6 +183,7 @@ var (
 	FindMainRouteTableAssociationByID                           = findMainRouteTableAssociationByID
 	FindManagedPrefixListByID                                   = findManagedPrefixListByID
 	FindManagedPrefixListEntryByIDAndCIDR                       = findManagedPrefixListEntryByIDAndCIDR
+	FindImageBlockPublicAccessState                             = findImageBlockPublicAccessState
 	FindNATGatewayByID                                          = findNATGatewayByID
 	FindNATGatewayAddressByNATGatewayIDAndAllocationIDSucceeded = findNATGatewayAddressByNATGatewayIDAndAllocationIDSucceeded
 	FindNetworkACLAssociationByID                               = findNetworkACLAssociationByID
@@ -287,6 +288,7 @@ var (
 	SecurityGroupExpandRules                                    = securityGroupExpandRules
 	SecurityGroupIPPermGather                                   = securityGroupIPPermGather
 	SecurityGroupMigrateState                                   = securityGroupMigrateState
+	ReferencedSecurityGroupIDEqual                              = referencedSecurityGroupIDEqual
 	SecurityGroupRuleCreateID                                   = securityGroupRuleCreateID
 	SecurityGroupRuleHash                                       = securityGroupRuleHash
 	SecurityGroupRuleMigrateState                               = securityGroupRuleMigrateState
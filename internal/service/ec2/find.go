@@ -145,6 +145,24 @@ func findCapacityReservationByID(ctx context.Context, conn *ec2.Client, id strin
 	return output, nil
 }
 
+func findCapacityBlockReservationByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.CapacityReservation, error) {
+	output, err := findCapacityReservationByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A Capacity Block whose upfront payment failed can never be used.
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/capacity-blocks-pricing-billing.html.
+	if state := output.State; state == awstypes.CapacityReservationStatePaymentFailed {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
 func findCOIPPool(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCoipPoolsInput) (*awstypes.CoipPool, error) {
 	output, err := findCOIPPools(ctx, conn, input)
 
@@ -4576,6 +4594,25 @@ func findImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (*st
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func findImageUEFIDataByID(ctx context.Context, conn *ec2.Client, id string) (string, error) {
+	input := ec2.DescribeImageAttributeInput{
+		Attribute: awstypes.ImageAttributeNameUefiData,
+		ImageId:   aws.String(id),
+	}
+
+	output, err := findImageAttribute(ctx, conn, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output.UefiData == nil {
+		return "", nil
+	}
+
+	return aws.ToString(output.UefiData.Value), nil
+}
+
 func findImageLaunchPermissionsByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.LaunchPermission, error) {
 	input := ec2.DescribeImageAttributeInput{
 		Attribute: awstypes.ImageAttributeNameLaunchPermission,
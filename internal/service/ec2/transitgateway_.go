@@ -293,7 +293,7 @@ func resourceTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	return diags
+	return append(diags, resourceTransitGatewayRead(ctx, d, meta)...)
 }
 
 func resourceTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
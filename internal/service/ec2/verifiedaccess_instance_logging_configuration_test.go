@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -164,6 +165,63 @@ func testAccVerifiedAccessInstanceLoggingConfiguration_accessLogsCloudWatchLogs(
 	})
 }
 
+func testAccVerifiedAccessInstanceLoggingConfiguration_trustProviderCloudWatchLogs(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v awstypes.VerifiedAccessInstanceLoggingConfiguration
+	resourceName := "aws_verifiedaccess_instance_logging_configuration.test"
+	instanceResourceName := "aws_verifiedaccess_instance.test"
+	trustProviderResourceName := "aws_verifiedaccess_trust_provider.test"
+	logGroupName := "aws_cloudwatch_log_group.test"
+	logGroupName2 := "aws_cloudwatch_log_group.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVerifiedAccessSynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccessInstanceLoggingConfiguration(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessInstanceLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingConfigurationConfig_trustProviderCloudWatchLogs("first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceLoggingConfigurationExists(ctx, resourceName, &v),
+					testAccCheckVerifiedAccessInstanceTrustProviderAttachmentExists(ctx, "aws_verifiedaccess_instance_trust_provider_attachment.test"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.cloudwatch_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.cloudwatch_logs.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "access_logs.0.cloudwatch_logs.0.log_group", logGroupName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.include_trust_context", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggingConfigurationConfig_trustProviderCloudWatchLogs("second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(instanceResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceLoggingConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.cloudwatch_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.cloudwatch_logs.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "access_logs.0.cloudwatch_logs.0.log_group", logGroupName2, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.include_trust_context", acctest.CtTrue),
+					resource.TestCheckResourceAttr(instanceResourceName, "verified_access_trust_providers.#", "1"),
+					resource.TestCheckResourceAttrPair(instanceResourceName, "verified_access_trust_providers.0.verified_access_trust_provider_id", trustProviderResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccVerifiedAccessInstanceLoggingConfiguration_accessLogsKinesisDataFirehose(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.VerifiedAccessInstanceLoggingConfiguration
@@ -701,6 +759,30 @@ resource "aws_verifiedaccess_instance_logging_configuration" "test" {
 `, selectLogGroup))
 }
 
+func testAccLoggingConfigurationConfig_trustProviderCloudWatchLogs(selectLogGroup string) string {
+	return acctest.ConfigCompose(
+		testAccVerifiedAccessInstanceTrustProviderAttachmentConfig_basic(),
+		testAccVerifiedAccessInstanceLoggingConfigurationConfig_cloudwatchTwoLogGroups(),
+		fmt.Sprintf(`
+locals {
+  select_log_group = %[1]q
+}
+
+resource "aws_verifiedaccess_instance_logging_configuration" "test" {
+  access_logs {
+    include_trust_context = true
+
+    cloudwatch_logs {
+      enabled   = true
+      log_group = local.select_log_group == "first" ? aws_cloudwatch_log_group.test.id : aws_cloudwatch_log_group.test2.id
+    }
+  }
+
+  verifiedaccess_instance_id = aws_verifiedaccess_instance_trust_provider_attachment.test.verifiedaccess_instance_id
+}
+`, selectLogGroup))
+}
+
 func testAccLoggingConfigurationConfig_basic_accessLogsKinesisDataFirehose(rName, rName2, rName3, selectStream string) string {
 	return acctest.ConfigCompose(
 		testAccVerifiedAccessInstanceLoggingConfigurationConfig_instance(),
@@ -55,6 +55,7 @@ func TestAccVerifiedAccess_serial(t *testing.T) {
 			"accessLogsS3":                                  testAccVerifiedAccessInstanceLoggingConfiguration_accessLogsS3,
 			"accessLogsCloudWatchLogsKinesisDataFirehoseS3": testAccVerifiedAccessInstanceLoggingConfiguration_accessLogsCloudWatchLogsKinesisDataFirehoseS3,
 			acctest.CtDisappears:                            testAccVerifiedAccessInstanceLoggingConfiguration_disappears,
+			"trustProviderCloudWatchLogs":                   testAccVerifiedAccessInstanceLoggingConfiguration_trustProviderCloudWatchLogs,
 		},
 		"InstanceTrustProviderAttachment": {
 			acctest.CtBasic:      testAccVerifiedAccessInstanceTrustProviderAttachment_basic,
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -62,6 +63,13 @@ func (r *vpcBlockPublicAccessOptionsResource) Schema(ctx context.Context, reques
 				CustomType: fwtypes.StringEnumType[awstypes.InternetGatewayBlockMode](),
 				Required:   true,
 			},
+			"last_update_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"reason": schema.StringAttribute{
+				Computed: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
@@ -99,13 +107,18 @@ func (r *vpcBlockPublicAccessOptionsResource) Create(ctx context.Context, reques
 	data.AWSRegion = fwflex.StringToFramework(ctx, output.VpcBlockPublicAccessOptions.AwsRegion)
 	data.ID = data.AWSRegion
 
-	if _, err := waitVPCBlockPublicAccessOptionsUpdated(ctx, conn, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+	options, err := waitVPCBlockPublicAccessOptionsUpdated(ctx, conn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
 		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Block Public Access Options (%s) create", data.ID.ValueString()), err.Error())
 
 		return
 	}
 
+	data.LastUpdateTimestamp = timetypes.NewRFC3339TimePointerValue(options.LastUpdateTimestamp)
+	data.Reason = fwflex.StringToFramework(ctx, options.Reason)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -163,12 +176,17 @@ func (r *vpcBlockPublicAccessOptionsResource) Update(ctx context.Context, reques
 		return
 	}
 
-	if _, err := waitVPCBlockPublicAccessOptionsUpdated(ctx, conn, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+	options, err := waitVPCBlockPublicAccessOptionsUpdated(ctx, conn, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Block Public Access Options (%s) update", new.ID.ValueString()), err.Error())
 
 		return
 	}
 
+	new.LastUpdateTimestamp = timetypes.NewRFC3339TimePointerValue(options.LastUpdateTimestamp)
+	new.Reason = fwflex.StringToFramework(ctx, options.Reason)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -207,5 +225,7 @@ type vpcBlockPublicAccessOptionsResourceModel struct {
 	AWSRegion                types.String                                          `tfsdk:"aws_region"`
 	ID                       types.String                                          `tfsdk:"id"`
 	InternetGatewayBlockMode fwtypes.StringEnum[awstypes.InternetGatewayBlockMode] `tfsdk:"internet_gateway_block_mode"`
+	LastUpdateTimestamp      timetypes.RFC3339                                     `tfsdk:"last_update_timestamp"`
+	Reason                   types.String                                          `tfsdk:"reason"`
 	Timeouts                 timeouts.Value                                        `tfsdk:"timeouts"`
 }
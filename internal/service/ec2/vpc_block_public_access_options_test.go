@@ -50,6 +50,7 @@ func testAccVPCBlockPublicAccessOptions_basic(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrAWSAccountID), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("aws_region"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("internet_gateway_block_mode"), knownvalue.StringExact(internetGatewayBlockMode)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("last_update_timestamp"), knownvalue.NotNull()),
 				},
 			},
 			{
@@ -296,7 +296,11 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 	data.Description = fwflex.StringToFramework(ctx, output.Description)
 	data.IPProtocol = fwflex.StringToFrameworkValuable[ipProtocol](ctx, output.IpProtocol)
 	data.PrefixListID = fwflex.StringToFramework(ctx, output.PrefixListId)
-	data.ReferencedSecurityGroupID = flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo, r.Meta().AccountID(ctx))
+	// Keep the configured "[UserID/]GroupID" form if it refers to the same security group.
+	accountID := r.Meta().AccountID(ctx)
+	if v := flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo, accountID); !referencedSecurityGroupIDEqual(data.ReferencedSecurityGroupID.ValueString(), v.ValueString(), accountID) {
+		data.ReferencedSecurityGroupID = v
+	}
 	data.SecurityGroupID = fwflex.StringToFramework(ctx, output.GroupId)
 	data.SecurityGroupRuleID = fwflex.StringToFramework(ctx, output.SecurityGroupRuleId)
 
@@ -428,6 +432,20 @@ func flattenReferencedSecurityGroup(ctx context.Context, apiObject *awstypes.Ref
 	return types.StringValue(strings.Join([]string{aws.ToString(apiObject.UserId), aws.ToString(apiObject.GroupId)}, "/"))
 }
 
+// referencedSecurityGroupIDEqual returns whether two referenced security group IDs refer to the same security group.
+// An ID without a user ID prefix is in the specified (current) account.
+func referencedSecurityGroupIDEqual(id1, id2, accountID string) bool {
+	normalize := func(id string) string {
+		if id == "" || strings.Contains(id, "/") {
+			return id
+		}
+
+		return accountID + "/" + id
+	}
+
+	return normalize(id1) == normalize(id2)
+}
+
 type securityGroupRuleResourceModel struct {
 	framework.WithRegionModel
 	ARN                       types.String `tfsdk:"arn"`
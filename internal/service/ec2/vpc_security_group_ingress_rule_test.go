@@ -11,6 +11,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -63,6 +64,55 @@ func TestIPProtocol(t *testing.T) {
 	}
 }
 
+func TestReferencedSecurityGroupIDEqual(t *testing.T) {
+	t.Parallel()
+
+	const accountID = "123456789012"
+	testCases := map[string]struct {
+		id1, id2 string
+		equals   bool
+	}{
+		"both empty": {
+			equals: true,
+		},
+		"same group ID": {
+			id1:    "sg-12345678",
+			id2:    "sg-12345678",
+			equals: true,
+		},
+		"different group ID": {
+			id1:    "sg-12345678",
+			id2:    "sg-87654321",
+			equals: false,
+		},
+		"current account prefix": {
+			id1:    "123456789012/sg-12345678",
+			id2:    "sg-12345678",
+			equals: true,
+		},
+		"other account prefix": {
+			id1:    "210987654321/sg-12345678",
+			id2:    "sg-12345678",
+			equals: false,
+		},
+		"one empty": {
+			id1:    "",
+			id2:    "sg-12345678",
+			equals: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.ReferencedSecurityGroupIDEqual(testCase.id1, testCase.id2, accountID), testCase.equals; got != want {
+				t.Errorf("ReferencedSecurityGroupIDEqual(%q, %q) = %v, want %v", testCase.id1, testCase.id2, got, want)
+			}
+		})
+	}
+}
+
 func TestAccVPCSecurityGroupIngressRule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.SecurityGroupRule
@@ -392,6 +442,52 @@ func TestAccVPCSecurityGroupIngressRule_description(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupIngressRule_descriptionOutOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_prefixListIDDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName, &v1),
+					testAccCheckSecurityGroupIngressRuleUpdateDescription(ctx, &v1, "out-of-band"),
+				),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_prefixListIDDescription(rName, "description1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName, &v2),
+					testAccCheckSecurityGroupRuleNotRecreated(&v2, &v1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", "aws_vpc_endpoint.test1", "prefix_list_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroupIngressRule_prefixListID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2 awstypes.SecurityGroupRule
@@ -691,6 +787,30 @@ func testAccCheckSecurityGroupIngressRuleUpdateTags(ctx context.Context, v *awst
 	}
 }
 
+func testAccCheckSecurityGroupIngressRuleUpdateDescription(ctx context.Context, v *awstypes.SecurityGroupRule, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.ModifySecurityGroupRulesInput{
+			GroupId: v.GroupId,
+			SecurityGroupRules: []awstypes.SecurityGroupRuleUpdate{{
+				SecurityGroupRule: &awstypes.SecurityGroupRuleRequest{
+					Description:  aws.String(description),
+					FromPort:     v.FromPort,
+					IpProtocol:   v.IpProtocol,
+					PrefixListId: v.PrefixListId,
+					ToPort:       v.ToPort,
+				},
+				SecurityGroupRuleId: v.SecurityGroupRuleId,
+			}},
+		}
+
+		_, err := conn.ModifySecurityGroupRules(ctx, &input)
+
+		return err
+	}
+}
+
 func testAccVPCSecurityGroupRuleConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -857,6 +977,20 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 `)
 }
 
+func testAccVPCSecurityGroupIngressRuleConfig_prefixListIDDescription(rName, description string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupIngressRuleConfig_prefixListIDBase(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  prefix_list_id = aws_vpc_endpoint.test1.prefix_list_id
+  description    = %[1]q
+  from_port      = 80
+  ip_protocol    = "tcp"
+  to_port        = 8080
+}
+`, description))
+}
+
 func testAccVPCSecurityGroupIngressRuleConfig_referencedSecurityGroupID(rName string) string {
 	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
 resource "aws_vpc_security_group_ingress_rule" "test" {
@@ -937,6 +937,48 @@ func TestAccVPCSecurityGroup_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_tags_ignoreTagsKeyPrefixes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.SecurityGroup
+	resourceName := "aws_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					testAccCheckSecurityGroupUpdateTags(ctx, &group, nil, map[string]string{"ignorekey1": "ignorevalue1", "ignorekey2": "ignorevalue2"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccVPCSecurityGroupConfig_name(rName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroup_noVPC(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group awstypes.SecurityGroup
@@ -2900,6 +2942,14 @@ func testSecurityGroupRuleCount(ctx context.Context, id string, expectedIngressC
 	return nil
 }
 
+func testAccCheckSecurityGroupUpdateTags(ctx context.Context, group *awstypes.SecurityGroup, oldTags, newTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		return tfec2.UpdateTags(ctx, conn, aws.ToString(group.GroupId), oldTags, newTags)
+	}
+}
+
 func testAccVPCSecurityGroupConfig_name(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
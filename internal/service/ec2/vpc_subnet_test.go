@@ -639,6 +639,52 @@ func TestAccVPCSubnet_ipv6Native(t *testing.T) {
 	})
 }
 
+func TestAccVPCSubnet_ipv6NativeDNS64(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Subnet
+	resourceName := "aws_subnet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetConfig_ipv6NativeDNS64(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, names.AttrCIDRBlock, ""),
+					resource.TestCheckResourceAttr(resourceName, "enable_dns64", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_native", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_hostname_type_on_launch", "resource-name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCSubnetConfig_ipv6NativeDNS64(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(ctx, resourceName, &after),
+					testAccCheckSubnetNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "enable_dns64", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_native", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_hostname_type_on_launch", "resource-name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSubnetIPv6BeforeUpdate(subnet *awstypes.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if subnet.Ipv6CidrBlockAssociationSet == nil {
@@ -1125,6 +1171,34 @@ resource "aws_subnet" "test" {
 `, rName)
 }
 
+func testAccVPCSubnetConfig_ipv6NativeDNS64(rName string, enableDNS64 bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.10.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id                          = aws_vpc.test.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  ipv6_native                     = true
+  enable_dns64                    = %[2]t
+
+  private_dns_hostname_type_on_launch            = "resource-name"
+  enable_resource_name_dns_aaaa_record_on_launch = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, enableDNS64)
+}
+
 func testAccVPCSubnetConfig_outpost(rName string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
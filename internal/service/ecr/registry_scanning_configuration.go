@@ -5,6 +5,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -33,6 +34,8 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateRegistryScanningConfigurationScanFrequency,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -164,6 +167,24 @@ func findRegistryScanningConfiguration(ctx context.Context, conn *ecr.Client) (*
 	return output, nil
 }
 
+func validateRegistryScanningConfigurationScanFrequency(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if scanType := d.Get("scan_type").(string); scanType == string(types.ScanTypeEnhanced) {
+		return nil
+	}
+
+	for _, rule := range d.Get(names.AttrRule).(*schema.Set).List() {
+		if rule == nil {
+			continue
+		}
+
+		if rule.(map[string]any)["scan_frequency"].(string) == string(types.ScanFrequencyContinuousScan) {
+			return fmt.Errorf("rule scan_frequency %s can only be used when scan_type is set to %s", types.ScanFrequencyContinuousScan, types.ScanTypeEnhanced)
+		}
+	}
+
+	return nil
+}
+
 // Helper functions
 
 func expandScanningRegistryRules(l []any) []types.RegistryScanningRule {
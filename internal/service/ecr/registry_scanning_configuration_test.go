@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,8 +22,10 @@ func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic: testAccRegistryScanningConfiguration_basic,
-		"update":        testAccRegistryScanningConfiguration_update,
+		acctest.CtBasic:           testAccRegistryScanningConfiguration_basic,
+		"update":                  testAccRegistryScanningConfiguration_update,
+		"continuousScanFilters":   testAccRegistryScanningConfiguration_continuousScanFilters,
+		"continuousScanBasicType": testAccRegistryScanningConfiguration_continuousScanBasicType,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -145,6 +148,71 @@ func testAccRegistryScanningConfigurationExists(ctx context.Context, n string, v
 	}
 }
 
+func testAccRegistryScanningConfiguration_continuousScanFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ecr.GetRegistryScanningConfigurationOutput
+	resourceName := "aws_ecr_registry_scanning_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningConfigurationConfig_continuousScanFilters(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegistryScanningConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"scan_frequency":      "CONTINUOUS_SCAN",
+						"repository_filter.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"scan_frequency":      "SCAN_ON_PUSH",
+						"repository_filter.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.repository_filter.*", map[string]string{
+						names.AttrFilter: "prod-*",
+						"filter_type":    "WILDCARD",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.repository_filter.*", map[string]string{
+						names.AttrFilter: "release/*",
+						"filter_type":    "WILDCARD",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.repository_filter.*", map[string]string{
+						names.AttrFilter: "*",
+						"filter_type":    "WILDCARD",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRegistryScanningConfiguration_continuousScanBasicType(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_continuousScanBasicType(),
+				ExpectError: regexache.MustCompile(`rule scan_frequency CONTINUOUS_SCAN can only be used when scan_type is set to ENHANCED`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationConfig_basic() string {
 	return `
 resource "aws_ecr_registry_scanning_configuration" "test" {
@@ -189,3 +257,44 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 }
 `
 }
+
+func testAccRegistryScanningConfigurationConfig_continuousScanFilters() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "ENHANCED"
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "prod-*"
+      filter_type = "WILDCARD"
+    }
+    repository_filter {
+      filter      = "release/*"
+      filter_type = "WILDCARD"
+    }
+  }
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
+
+func testAccRegistryScanningConfigurationConfig_continuousScanBasicType() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
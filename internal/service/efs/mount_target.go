@@ -113,7 +113,32 @@ func resourceMountTarget() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: validateMountTargetIPAddresses,
+	}
+}
+
+func validateMountTargetIPAddresses(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	// Check configured values only, the computed addresses are always read back.
+	config := d.GetRawConfig()
+	ipAddressType := config.GetAttr(names.AttrIPAddressType)
+
+	if !ipAddressType.IsKnown() || ipAddressType.IsNull() {
+		return nil
 	}
+
+	switch v := awstypes.IpAddressType(ipAddressType.AsString()); v {
+	case awstypes.IpAddressTypeIpv4Only:
+		if !config.GetAttr("ipv6_address").IsNull() {
+			return fmt.Errorf("ipv6_address cannot be specified when ip_address_type is %q", v)
+		}
+	case awstypes.IpAddressTypeIpv6Only:
+		if !config.GetAttr(names.AttrIPAddress).IsNull() {
+			return fmt.Errorf("ip_address cannot be specified when ip_address_type is %q", v)
+		}
+	}
+
+	return nil
 }
 
 func resourceMountTargetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
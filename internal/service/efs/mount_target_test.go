@@ -209,6 +209,24 @@ func TestAccEFSMountTarget_ipAddressTypeDualStack(t *testing.T) {
 	})
 }
 
+func TestAccEFSMountTarget_ipAddressTypeMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMountTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMountTargetConfig_ipAddressTypeIPv4OnlyWithIPv6Address(rName),
+				ExpectError: regexache.MustCompile(`ipv6_address cannot be specified when ip_address_type is "IPV4_ONLY"`),
+			},
+		},
+	})
+}
+
 func TestAccEFSMountTarget_ipAddressTypeDualStackWithIPv6Address(t *testing.T) {
 	ctx := acctest.Context(t)
 	var mount awstypes.MountTargetDescription
@@ -435,3 +453,14 @@ resource "aws_efs_mount_target" "test" {
 }
 `)
 }
+
+func testAccMountTargetConfig_ipAddressTypeIPv4OnlyWithIPv6Address(rName string) string {
+	return acctest.ConfigCompose(testAccMountTargetConfig_withDualStackSubnet(rName), `
+resource "aws_efs_mount_target" "test" {
+  file_system_id  = aws_efs_file_system.test.id
+  ip_address_type = "IPV4_ONLY"
+  ipv6_address    = cidrhost(aws_subnet.test[0].ipv6_cidr_block, 10)
+  subnet_id       = aws_subnet.test[0].id
+}
+`)
+}
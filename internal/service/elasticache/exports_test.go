@@ -15,11 +15,13 @@ var (
 	ResourceUserGroup              = resourceUserGroup
 	ResourceUserGroupAssociation   = resourceUserGroupAssociation
 
+	FailoverGlobalReplicationGroup       = failoverGlobalReplicationGroup
 	FindCacheClusterByID                 = findCacheClusterByID
 	FindCacheParameterGroup              = findCacheParameterGroup
 	FindCacheParameterGroupByName        = findCacheParameterGroupByName
 	FindCacheSubnetGroupByName           = findCacheSubnetGroupByName
 	FindGlobalReplicationGroupByID       = findGlobalReplicationGroupByID
+	FindGlobalReplicationGroupMember     = findGlobalReplicationGroupMember
 	FindReplicationGroupByID             = findReplicationGroupByID
 	FindReservedCacheNodeByID            = findReservedCacheNodeByID
 	FindServerlessCacheByID              = findServerlessCacheByID
@@ -28,6 +30,7 @@ var (
 	FindUserGroupAssociationByTwoPartKey = findUserGroupAssociationByTwoPartKey
 	ParameterChanges                     = parameterChanges
 	ParameterHash                        = parameterHash
+	RebalanceGlobalReplicationGroupSlots = rebalanceGlobalReplicationGroupSlots
 	WaitCacheClusterDeleted              = waitCacheClusterDeleted
 	WaitReplicationGroupAvailable        = waitReplicationGroupAvailable
 
@@ -195,9 +195,12 @@ func resourceGlobalReplicationGroup() *schema.Resource {
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateReplicationGroupID,
 			},
+			"rebalance_slots": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"transit_encryption_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -300,7 +303,7 @@ func resourceGlobalReplicationGroupCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(aws.ToString(output.GlobalReplicationGroup.GlobalReplicationGroupId))
 
-	globalReplicationGroup, err := waitGlobalReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	globalReplicationGroup, err := waitGlobalReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate), globalReplicationGroupDefaultDelay)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Global Replication Group (%s) create: %s", d.Id(), err)
@@ -495,6 +498,12 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	if d.HasChange("primary_replication_group_id") {
+		if err := failoverGlobalReplicationGroup(ctx, conn, d.Id(), d.Get("primary_replication_group_id").(string), d.Timeout(schema.TimeoutUpdate), globalReplicationGroupDefaultDelay); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("global_replication_group_description") {
 		if err := updateGlobalReplicationGroup(ctx, conn, d.Id(), globalReplicationGroupDescriptionUpdater(d.Get("global_replication_group_description").(string)), names.AttrDescription, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -519,6 +528,12 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			}
+
+			if d.Get("rebalance_slots").(bool) {
+				if err := rebalanceGlobalReplicationGroupSlots(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), globalReplicationGroupDefaultDelay); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
 		}
 	}
 
@@ -583,7 +598,7 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.Client,
 		return fmt.Errorf("updating ElastiCache Global Replication Group (%s) %s: %w", id, propertyName, err)
 	}
 
-	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout, globalReplicationGroupDefaultDelay); err != nil {
 		return fmt.Errorf("waiting for ElastiCache Global Replication Group (%s) update: %w", id, err)
 	}
 
@@ -603,7 +618,7 @@ func increaseGlobalReplicationGroupNodeGroupCount(ctx context.Context, conn *ela
 		return fmt.Errorf("increasing ElastiCache Global Replication Group (%s) node group count (%d): %w", id, newNodeGroupCount, err)
 	}
 
-	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout, globalReplicationGroupDefaultDelay); err != nil {
 		return fmt.Errorf("waiting for ElastiCache Global Replication Group (%s) update: %w", id, err)
 	}
 
@@ -635,7 +650,57 @@ func decreaseGlobalReplicationGroupNodeGroupCount(ctx context.Context, conn *ela
 		return fmt.Errorf("decreasing ElastiCache Global Replication Group (%s) node group count (%d): %w", id, newNodeGroupCount, err)
 	}
 
-	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout, globalReplicationGroupDefaultDelay); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Global Replication Group (%s) update: %w", id, err)
+	}
+
+	return nil
+}
+
+func failoverGlobalReplicationGroup(ctx context.Context, conn *elasticache.Client, id, primaryReplicationGroupID string, timeout, delay time.Duration) error {
+	globalReplicationGroup, err := findGlobalReplicationGroupByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading ElastiCache Global Replication Group (%s): %w", id, err)
+	}
+
+	member, ok := findGlobalReplicationGroupMember(globalReplicationGroup.Members, primaryReplicationGroupID)
+	if !ok {
+		return fmt.Errorf("failing over ElastiCache Global Replication Group (%s): replication group (%s) is not a member; only an existing secondary member can be promoted to primary", id, primaryReplicationGroupID)
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             member.ReplicationGroupRegion,
+		PrimaryReplicationGroupId: aws.String(primaryReplicationGroupID),
+	}
+
+	_, err = conn.FailoverGlobalReplicationGroup(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("failing over ElastiCache Global Replication Group (%s) to replication group (%s): %w", id, primaryReplicationGroupID, err)
+	}
+
+	if _, err := waitGlobalReplicationGroupPrimary(ctx, conn, id, primaryReplicationGroupID, timeout, delay); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Global Replication Group (%s) failover: %w", id, err)
+	}
+
+	return nil
+}
+
+func rebalanceGlobalReplicationGroupSlots(ctx context.Context, conn *elasticache.Client, id string, timeout, delay time.Duration) error {
+	input := &elasticache.RebalanceSlotsInGlobalReplicationGroupInput{
+		ApplyImmediately:         aws.Bool(true),
+		GlobalReplicationGroupId: aws.String(id),
+	}
+
+	_, err := conn.RebalanceSlotsInGlobalReplicationGroup(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("rebalancing ElastiCache Global Replication Group (%s) slots: %w", id, err)
+	}
+
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout, delay); err != nil {
 		return fmt.Errorf("waiting for ElastiCache Global Replication Group (%s) update: %w", id, err)
 	}
 
@@ -660,7 +725,7 @@ func deleteGlobalReplicationGroup(ctx context.Context, conn *elasticache.Client,
 		return fmt.Errorf("deleting ElastiCache Global Replication Group (%s): %w", id, err)
 	}
 
-	if _, err := waitGlobalReplicationGroupDeleted(ctx, conn, id, deleteTimeout); err != nil {
+	if _, err := waitGlobalReplicationGroupDeleted(ctx, conn, id, deleteTimeout, globalReplicationGroupDefaultDelay); err != nil {
 		return fmt.Errorf("waiting for ElastiCache Global Replication Group (%s) delete: %w", id, err)
 	}
 
@@ -734,6 +799,7 @@ const (
 	globalReplicationGroupDefaultCreatedTimeout = 60 * time.Minute
 	globalReplicationGroupDefaultUpdatedTimeout = 60 * time.Minute
 	globalReplicationGroupDefaultDeletedTimeout = 20 * time.Minute
+	globalReplicationGroupDefaultDelay          = 30 * time.Second
 )
 
 const (
@@ -745,14 +811,58 @@ const (
 	globalReplicationGroupStatusPrimaryOnly = "primary-only"
 )
 
-func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID string, timeout time.Duration) (*awstypes.GlobalReplicationGroup, error) {
+func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID string, timeout, delay time.Duration) (*awstypes.GlobalReplicationGroup, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{globalReplicationGroupStatusCreating, globalReplicationGroupStatusModifying},
 		Target:     []string{globalReplicationGroupStatusAvailable, globalReplicationGroupStatusPrimaryOnly},
 		Refresh:    statusGlobalReplicationGroup(conn, globalReplicationGroupID),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.GlobalReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusGlobalReplicationGroupPrimary(conn *elasticache.Client, globalReplicationGroupID, primaryReplicationGroupID string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findGlobalReplicationGroupByID(ctx, conn, globalReplicationGroupID)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.ToString(output.Status); status != globalReplicationGroupStatusAvailable && status != globalReplicationGroupStatusPrimaryOnly {
+			return output, status, nil
+		}
+
+		// The group can report available before the member roles have been swapped.
+		if flattenGlobalReplicationGroupPrimaryGroupID(output.Members) != primaryReplicationGroupID {
+			return output, globalReplicationGroupStatusModifying, nil
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitGlobalReplicationGroupPrimary(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID, primaryReplicationGroupID string, timeout, delay time.Duration) (*awstypes.GlobalReplicationGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{globalReplicationGroupStatusModifying},
+		Target:     []string{globalReplicationGroupStatusAvailable, globalReplicationGroupStatusPrimaryOnly},
+		Refresh:    statusGlobalReplicationGroupPrimary(conn, globalReplicationGroupID, primaryReplicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      delay,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -764,7 +874,7 @@ func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.
 	return nil, err
 }
 
-func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID string, timeout time.Duration) (*awstypes.GlobalReplicationGroup, error) {
+func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID string, timeout, delay time.Duration) (*awstypes.GlobalReplicationGroup, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			globalReplicationGroupStatusAvailable,
@@ -776,7 +886,7 @@ func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.Cl
 		Refresh:    statusGlobalReplicationGroup(conn, globalReplicationGroupID),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Delay:      delay,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -830,14 +940,14 @@ const (
 	globalReplicationGroupMemberStatusAssociated = "associated"
 )
 
-func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID, replicationGroupID string, timeout time.Duration) (*awstypes.GlobalReplicationGroupMember, error) {
+func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID, replicationGroupID string, timeout, delay time.Duration) (*awstypes.GlobalReplicationGroupMember, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{globalReplicationGroupMemberStatusAssociated},
 		Target:     []string{},
 		Refresh:    statusGlobalReplicationGroupMember(conn, globalReplicationGroupID, replicationGroupID),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Delay:      delay,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -895,6 +1005,15 @@ func flattenGlobalReplicationGroupPrimaryGroupID(members []awstypes.GlobalReplic
 	return ""
 }
 
+func findGlobalReplicationGroupMember(members []awstypes.GlobalReplicationGroupMember, replicationGroupID string) (*awstypes.GlobalReplicationGroupMember, bool) {
+	for _, member := range members {
+		if aws.ToString(member.ReplicationGroupId) == replicationGroupID {
+			return &member, true
+		}
+	}
+	return nil, false
+}
+
 func globalReplicationGroupNodeNumber(id string) int {
 	re := regexache.MustCompile(`^.+-0{0,3}(\d+)$`)
 	matches := re.FindStringSubmatch(id)
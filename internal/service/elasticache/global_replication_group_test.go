@@ -6,13 +6,21 @@ package elasticache_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -607,6 +615,345 @@ func TestAccElastiCacheGlobalReplicationGroup_clusterMode_basic(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheGlobalReplicationGroup_clusterMode_rebalanceSlots(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalReplicationGroup awstypes.GlobalReplicationGroup
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckGlobalReplicationGroup(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalReplicationGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalReplicationGroupConfig_clusterModeRebalanceSlots(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, t, resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "num_node_groups", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rebalance_slots", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_clusterModeRebalanceSlots(rName, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, t, resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "num_node_groups", "3"),
+					resource.TestCheckResourceAttr(resourceName, "global_node_groups.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rebalance_slots", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rebalance_slots"},
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheGlobalReplicationGroup_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalReplicationGroup awstypes.GlobalReplicationGroup
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	primaryReplicationGroupId := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	secondaryReplicationGroupId := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalReplicationGroup(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckGlobalReplicationGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, primaryReplicationGroupId, secondaryReplicationGroupId, primaryReplicationGroupId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, t, resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", primaryReplicationGroupId),
+				),
+			},
+			{
+				Config:      testAccGlobalReplicationGroupConfig_failover(rName, primaryReplicationGroupId, secondaryReplicationGroupId, rName),
+				ExpectError: regexache.MustCompile(`replication group \(` + rName + `\) is not a member`),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, primaryReplicationGroupId, secondaryReplicationGroupId, secondaryReplicationGroupId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, t, resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", secondaryReplicationGroupId),
+				),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, primaryReplicationGroupId, secondaryReplicationGroupId, primaryReplicationGroupId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, t, resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", primaryReplicationGroupId),
+				),
+			},
+		},
+	})
+}
+
+func TestFindGlobalReplicationGroupMember(t *testing.T) {
+	t.Parallel()
+
+	members := []awstypes.GlobalReplicationGroupMember{
+		{
+			ReplicationGroupId:     aws.String("primary"),
+			ReplicationGroupRegion: aws.String("us-west-2"),
+			Role:                   aws.String("PRIMARY"),
+		},
+		{
+			ReplicationGroupId:     aws.String("secondary"),
+			ReplicationGroupRegion: aws.String("us-east-1"),
+			Role:                   aws.String("SECONDARY"),
+		},
+	}
+
+	testCases := map[string]struct {
+		replicationGroupID string
+		expectedOK         bool
+		expectedRegion     string
+	}{
+		"primary": {
+			replicationGroupID: "primary",
+			expectedOK:         true,
+			expectedRegion:     "us-west-2",
+		},
+		"secondary": {
+			replicationGroupID: "secondary",
+			expectedOK:         true,
+			expectedRegion:     "us-east-1",
+		},
+		"not a member": {
+			replicationGroupID: "other",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			member, ok := tfelasticache.FindGlobalReplicationGroupMember(members, testCase.replicationGroupID)
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Fatalf("ok = %t, want %t", got, want)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got, want := aws.ToString(member.ReplicationGroupRegion), testCase.expectedRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// stubGlobalReplicationGroup is an ElastiCache Query API endpoint serving a single global replication group
+// with a primary member in us-west-2 and a secondary member in us-east-1.
+type stubGlobalReplicationGroup struct {
+	mu      sync.Mutex
+	id      string
+	primary string
+	actions []url.Values
+}
+
+func newStubGlobalReplicationGroupClient(t *testing.T, id string) (*elasticache.Client, *stubGlobalReplicationGroup) {
+	t.Helper()
+
+	stub := &stubGlobalReplicationGroup{
+		id:      id,
+		primary: "primary",
+	}
+	server := httptest.NewServer(http.HandlerFunc(stub.serveHTTP))
+	t.Cleanup(server.Close)
+
+	conn := elasticache.New(elasticache.Options{
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:       endpoints.UsWest2RegionID,
+	})
+
+	return conn, stub
+}
+
+func (s *stubGlobalReplicationGroup) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	action := r.Form.Get("Action")
+	s.actions = append(s.actions, r.Form)
+
+	switch action {
+	case "FailoverGlobalReplicationGroup":
+		s.primary = r.Form.Get("PrimaryReplicationGroupId")
+	case "DescribeGlobalReplicationGroups", "RebalanceSlotsInGlobalReplicationGroup":
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	group := s.globalReplicationGroupXML()
+	if action == "DescribeGlobalReplicationGroups" {
+		group = "<GlobalReplicationGroups><GlobalReplicationGroup>" + group + "</GlobalReplicationGroup></GlobalReplicationGroups>"
+	} else {
+		group = "<GlobalReplicationGroup>" + group + "</GlobalReplicationGroup>"
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	fmt.Fprintf(w, `<%[1]sResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/"><%[1]sResult>%[2]s</%[1]sResult></%[1]sResponse>`, action, group)
+}
+
+func (s *stubGlobalReplicationGroup) globalReplicationGroupXML() string {
+	var members strings.Builder
+	for _, v := range []struct{ id, region string }{{"primary", endpoints.UsWest2RegionID}, {"secondary", endpoints.UsEast1RegionID}} {
+		role := "SECONDARY"
+		if v.id == s.primary {
+			role = "PRIMARY"
+		}
+		fmt.Fprintf(&members, `<GlobalReplicationGroupMember><ReplicationGroupId>%s</ReplicationGroupId><ReplicationGroupRegion>%s</ReplicationGroupRegion><Role>%s</Role><Status>associated</Status></GlobalReplicationGroupMember>`, v.id, v.region, role)
+	}
+
+	return fmt.Sprintf(`<GlobalReplicationGroupId>%s</GlobalReplicationGroupId><Status>available</Status><Members>%s</Members>`, s.id, members.String())
+}
+
+func (s *stubGlobalReplicationGroup) calls(action string) []url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var calls []url.Values
+	for _, v := range s.actions {
+		if v.Get("Action") == action {
+			calls = append(calls, v)
+		}
+	}
+
+	return calls
+}
+
+func TestFailoverGlobalReplicationGroup(t *testing.T) {
+	t.Parallel()
+
+	const id = "ldgnf-global"
+
+	testCases := map[string]struct {
+		primaryReplicationGroupID string
+		expectedErr               string
+		expectedRegion            string
+	}{
+		"secondary": {
+			primaryReplicationGroupID: "secondary",
+			expectedRegion:            endpoints.UsEast1RegionID,
+		},
+		"not a member": {
+			primaryReplicationGroupID: "other",
+			expectedErr:               "replication group (other) is not a member",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			conn, stub := newStubGlobalReplicationGroupClient(t, id)
+
+			err := tfelasticache.FailoverGlobalReplicationGroup(ctx, conn, id, testCase.primaryReplicationGroupID, time.Minute, 0)
+			calls := stub.calls("FailoverGlobalReplicationGroup")
+
+			if testCase.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.expectedErr, err)
+				}
+				if len(calls) != 0 {
+					t.Errorf("FailoverGlobalReplicationGroup called %d times, want 0", len(calls))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(calls) != 1 {
+				t.Fatalf("FailoverGlobalReplicationGroup called %d times, want 1", len(calls))
+			}
+			if got, want := calls[0].Get("GlobalReplicationGroupId"), id; got != want {
+				t.Errorf("GlobalReplicationGroupId = %q, want %q", got, want)
+			}
+			if got, want := calls[0].Get("PrimaryReplicationGroupId"), testCase.primaryReplicationGroupID; got != want {
+				t.Errorf("PrimaryReplicationGroupId = %q, want %q", got, want)
+			}
+			if got, want := calls[0].Get("PrimaryRegion"), testCase.expectedRegion; got != want {
+				t.Errorf("PrimaryRegion = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRebalanceGlobalReplicationGroupSlots(t *testing.T) {
+	t.Parallel()
+
+	const id = "ldgnf-global"
+
+	ctx := acctest.Context(t)
+	conn, stub := newStubGlobalReplicationGroupClient(t, id)
+
+	if err := tfelasticache.RebalanceGlobalReplicationGroupSlots(ctx, conn, id, time.Minute, 0); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	calls := stub.calls("RebalanceSlotsInGlobalReplicationGroup")
+	if len(calls) != 1 {
+		t.Fatalf("RebalanceSlotsInGlobalReplicationGroup called %d times, want 1", len(calls))
+	}
+	if got, want := calls[0].Get("GlobalReplicationGroupId"), id; got != want {
+		t.Errorf("GlobalReplicationGroupId = %q, want %q", got, want)
+	}
+	if got, want := calls[0].Get("ApplyImmediately"), "true"; got != want {
+		t.Errorf("ApplyImmediately = %q, want %q", got, want)
+	}
+
+	if len(stub.calls("DescribeGlobalReplicationGroups")) == 0 {
+		t.Error("expected the rebalance to be waited on")
+	}
+}
+
 func TestAccElastiCacheGlobalReplicationGroup_SetNumNodeGroupsOnCreate_NoChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2186,6 +2533,36 @@ resource "aws_elasticache_replication_group" "test" {
 `, rName)
 }
 
+func testAccGlobalReplicationGroupConfig_clusterModeRebalanceSlots(rName string, globalNumNodeGroups int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = aws_elasticache_replication_group.test.id
+
+  num_node_groups = %[2]d
+  rebalance_slots = true
+}
+
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id = %[1]q
+  description          = "test"
+
+  engine         = "redis"
+  engine_version = "6.2"
+  node_type      = "cache.m5.large"
+
+  parameter_group_name       = "default.redis6.x.cluster.on"
+  automatic_failover_enabled = true
+  num_node_groups            = 2
+  replicas_per_node_group    = 1
+
+  lifecycle {
+    ignore_changes = [member_clusters, num_node_groups]
+  }
+}
+`, rName, globalNumNodeGroups)
+}
+
 func testAccGlobalReplicationGroupConfig_numNodeGroups_inherit(rName string, numNodeGroups int) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_global_replication_group" "test" {
@@ -2373,6 +2750,41 @@ resource "aws_elasticache_replication_group" "secondary" {
 `, rName, primaryReplicationGroupId, secondaryReplicationGroupId))
 }
 
+func testAccGlobalReplicationGroupConfig_failover(rName, primaryReplicationGroupId, secondaryReplicationGroupId, globalPrimaryReplicationGroupId string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = %[4]q
+
+  depends_on = [aws_elasticache_replication_group.test]
+}
+
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id       = %[2]q
+  description                = "test"
+  engine                     = "redis"
+  engine_version             = "7.1"
+  node_type                  = "cache.m5.large"
+  num_cache_clusters         = 2
+  automatic_failover_enabled = true
+
+  lifecycle {
+    ignore_changes = [global_replication_group_id]
+  }
+}
+
+resource "aws_elasticache_replication_group" "secondary" {
+  provider = awsalternate
+
+  replication_group_id        = %[3]q
+  description                 = "test secondary"
+  global_replication_group_id = aws_elasticache_global_replication_group.test.id
+  num_cache_clusters          = 2
+  automatic_failover_enabled  = true
+}
+`, rName, primaryReplicationGroupId, secondaryReplicationGroupId, globalPrimaryReplicationGroupId))
+}
+
 func testAccGlobalReplicationGroupConfig_engineVersionCustomParam(rName, primaryReplicationGroupId, repGroupEngineVersion, globalEngineVersion, parameterGroupName, parameterGroupFamily string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_global_replication_group" "test" {
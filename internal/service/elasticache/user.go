@@ -5,12 +5,14 @@ package elasticache
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -122,7 +124,38 @@ func resourceUser() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: resourceUserCustomizeDiff,
+	}
+}
+
+func resourceUserCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	config := diff.GetRawConfig()
+
+	authenticationMode := config.GetAttr("authentication_mode")
+	if !authenticationMode.IsKnown() || authenticationMode.IsNull() || authenticationMode.LengthInt() == 0 {
+		return nil
+	}
+	authenticationMode = authenticationMode.Index(cty.NumberIntVal(0))
+
+	if v := authenticationMode.GetAttr(names.AttrType); !v.IsKnown() || v.IsNull() || v.AsString() != string(awstypes.InputAuthenticationTypeIam) {
+		return nil
+	}
+
+	// IAM-authenticated users are password-less.
+	if v := config.GetAttr("passwords"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		return fmt.Errorf(`"passwords" cannot be specified when authentication_mode.type is %q`, awstypes.InputAuthenticationTypeIam)
+	}
+
+	if v := authenticationMode.GetAttr("passwords"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		return fmt.Errorf(`"authentication_mode.0.passwords" cannot be specified when authentication_mode.type is %q`, awstypes.InputAuthenticationTypeIam)
+	}
+
+	if diff.Get("no_password_required").(bool) {
+		return fmt.Errorf(`"no_password_required" cannot be true when authentication_mode.type is %q`, awstypes.InputAuthenticationTypeIam)
 	}
+
+	return nil
 }
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
@@ -127,6 +128,24 @@ func TestAccElastiCacheUser_iamAuthMode(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheUser_iamAuthModeWithPasswords(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserConfigWithIAMAuthMode_passwords(rName),
+				ExpectError: regexache.MustCompile(`"authentication_mode.0.passwords" cannot be specified when authentication_mode.type is "iam"`),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheUser_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user awstypes.User
@@ -488,6 +507,22 @@ resource "aws_elasticache_user" "test" {
 `, rName)
 }
 
+func testAccUserConfigWithIAMAuthMode_passwords(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id       = %[1]q
+  user_name     = %[1]q
+  access_string = "on ~app::* -@all +@read"
+  engine        = "redis"
+
+  authentication_mode {
+    type      = "iam"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+}
+`, rName)
+}
+
 func testAccUserConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_user" "test" {
@@ -79,6 +79,29 @@ func TestAccELBV2ListenerDataSource_mutualAuthentication(t *testing.T) {
 	})
 }
 
+func TestAccELBV2ListenerDataSource_tcpIdleTimeoutSeconds(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener.test"
+	dataSourceName := "data.aws_lb_listener.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerDataSourceConfig_tcpIdleTimeoutSeconds(rName, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrProtocol, "TCP"),
+					resource.TestCheckResourceAttr(dataSourceName, "tcp_idle_timeout_seconds", "500"),
+				),
+			},
+		},
+	})
+}
+
 func testAccListenerDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccListenerConfig_base(rName), fmt.Sprintf(`
 resource "aws_lb_listener" "test" {
@@ -142,3 +165,11 @@ data "aws_lb_listener" "test" {
 }
 `)
 }
+
+func testAccListenerDataSourceConfig_tcpIdleTimeoutSeconds(rName string, seconds int) string {
+	return acctest.ConfigCompose(testAccListenerConfig_attributes_nlbTCPIdleTimeoutSeconds(rName, seconds), `
+data "aws_lb_listener" "test" {
+  arn = aws_lb_listener.test.arn
+}
+`)
+}
@@ -1303,12 +1303,19 @@ func customizeDiffLoadBalancerNLB(_ context.Context, diff *schema.ResourceDiff,
 		return nil
 	}
 
+	config := diff.GetRawConfig()
+
+	// Inbound rule enforcement for PrivateLink traffic is only meaningful with security groups attached.
+	if v := config.GetAttr("enforce_security_group_inbound_rules_on_private_link_traffic"); v.IsKnown() && !v.IsNull() && v.AsString() == string(awstypes.EnforceSecurityGroupInboundRulesOnPrivateLinkTrafficEnumOn) {
+		if v := config.GetAttr(names.AttrSecurityGroups); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+			return errors.New(`"enforce_security_group_inbound_rules_on_private_link_traffic" = "on" requires "security_groups" to be configured`)
+		}
+	}
+
 	if diff.Id() == "" {
 		return nil
 	}
 
-	config := diff.GetRawConfig()
-
 	// Subnet diffs.
 	// Check for changes here -- SetNewComputed will modify HasChange.
 	hasSubnetMappingChanges, hasSubnetsChanges := diff.HasChange("subnet_mapping"), diff.HasChange(names.AttrSubnets)
@@ -1396,12 +1403,12 @@ func customizeDiffLoadBalancerALB(_ context.Context, diff *schema.ResourceDiff,
 		return nil
 	}
 
+	config := diff.GetRawConfig()
+
 	if diff.Id() == "" {
 		return nil
 	}
 
-	config := diff.GetRawConfig()
-
 	// Subnet diffs.
 	// Check for changes here -- SetNewComputed will modify HasChange.
 	hasSubnetMappingChanges, hasSubnetsChanges := diff.HasChange("subnet_mapping"), diff.HasChange(names.AttrSubnets)
@@ -50,6 +50,8 @@ func ResourceFeature() *schema.Resource {
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffFeatureVariations,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -367,6 +369,67 @@ func resourceFeatureDelete(ctx context.Context, d *schema.ResourceData, meta any
 	return diags
 }
 
+func customizeDiffFeatureVariations(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("variations") {
+		return nil
+	}
+
+	var valueType awstypes.VariationValueType
+	variationNames := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("variations").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		variationNames[name] = struct{}{}
+
+		var valueTypes []awstypes.VariationValueType
+		if v, ok := tfMap[names.AttrValue].([]any); ok && len(v) > 0 && v[0] != nil {
+			value := v[0].(map[string]any)
+
+			if value["bool_value"].(string) != "" {
+				valueTypes = append(valueTypes, awstypes.VariationValueTypeBoolean)
+			}
+			if value["double_value"].(string) != "" {
+				valueTypes = append(valueTypes, awstypes.VariationValueTypeDouble)
+			}
+			if value["long_value"].(string) != "" {
+				valueTypes = append(valueTypes, awstypes.VariationValueTypeLong)
+			}
+			if value["string_value"].(string) != "" {
+				valueTypes = append(valueTypes, awstypes.VariationValueTypeString)
+			}
+		}
+
+		switch len(valueTypes) {
+		case 0:
+			valueTypes = append(valueTypes, awstypes.VariationValueTypeString)
+		case 1:
+		default:
+			return fmt.Errorf("variation (%s) value must set only one of bool_value, double_value, long_value or string_value", name)
+		}
+
+		if valueType == "" {
+			valueType = valueTypes[0]
+		} else if valueType != valueTypes[0] {
+			return fmt.Errorf("all variations must have the same value type, got %s and %s", valueType, valueTypes[0])
+		}
+	}
+
+	if d.NewValueKnown("entity_overrides") {
+		for entity, v := range d.Get("entity_overrides").(map[string]any) {
+			if _, ok := variationNames[v.(string)]; !ok {
+				return fmt.Errorf("entity_overrides (%s) references unknown variation (%s)", entity, v)
+			}
+		}
+	}
+
+	return nil
+}
+
 func FeatureParseID(id string) (string, string, error) {
 	featureName, projectNameOrARN, _ := strings.Cut(id, ":")
 
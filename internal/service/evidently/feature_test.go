@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/evidently/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -541,6 +542,36 @@ func TestAccEvidentlyFeature_updateVariationsStringValue(t *testing.T) {
 	})
 }
 
+func TestAccEvidentlyFeature_invalidVariations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EvidentlyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EvidentlyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFeatureConfig_variationsMixedValueTypes(rName, rName2),
+				ExpectError: regexache.MustCompile(`all variations must have the same value type`),
+			},
+			{
+				Config:      testAccFeatureConfig_variationsMultipleValues(rName, rName2),
+				ExpectError: regexache.MustCompile(`value must set only one of bool_value, double_value, long_value or string_value`),
+			},
+			{
+				Config:      testAccFeatureConfig_entityOverridesUnknownVariation(rName, rName2),
+				ExpectError: regexache.MustCompile(`entity_overrides \(test1\) references unknown variation \(Variation3\)`),
+			},
+		},
+	})
+}
+
 func TestAccEvidentlyFeature_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var feature awstypes.Feature
@@ -1012,6 +1043,93 @@ resource "aws_evidently_feature" "test" {
 `, rName2, variationName1, stringVal1, variationName2, stringVal2))
 }
 
+func testAccFeatureConfig_variationsMixedValueTypes(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_evidently_feature" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  variations {
+    name = "Variation1"
+    value {
+      bool_value = true
+    }
+  }
+
+  variations {
+    name = "Variation2"
+    value {
+      double_value = 1.5
+    }
+  }
+
+  variations {
+    name = "Variation3"
+    value {
+      long_value = 10
+    }
+  }
+
+  variations {
+    name = "Variation4"
+    value {
+      string_value = "four"
+    }
+  }
+}
+`, rName2))
+}
+
+func testAccFeatureConfig_variationsMultipleValues(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_evidently_feature" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  variations {
+    name = "Variation1"
+    value {
+      long_value   = 10
+      double_value = 1.5
+    }
+  }
+}
+`, rName2))
+}
+
+func testAccFeatureConfig_entityOverridesUnknownVariation(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_evidently_feature" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  entity_overrides = {
+    test1 = "Variation3"
+  }
+
+  variations {
+    name = "Variation1"
+    value {
+      string_value = "one"
+    }
+  }
+
+  variations {
+    name = "Variation2"
+    value {
+      string_value = "two"
+    }
+  }
+}
+`, rName2))
+}
+
 func testAccFeatureConfig_tags1(rName, rName2, tag, value string) string {
 	return acctest.ConfigCompose(
 		testAccFeatureConfigBase(rName),
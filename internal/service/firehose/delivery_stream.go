@@ -865,14 +865,16 @@ func resourceDeliveryStream() *schema.Resource {
 								ForceNew: true,
 							},
 							"buffering_interval": {
-								Type:     schema.TypeInt,
-								Optional: true,
-								Default:  300,
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      300,
+								ValidateFunc: validation.IntBetween(0, 900),
 							},
 							"buffering_size": {
-								Type:     schema.TypeInt,
-								Optional: true,
-								Default:  5,
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      5,
+								ValidateFunc: validation.IntBetween(1, 128),
 							},
 							"catalog_arn": {
 								Type:         schema.TypeString,
@@ -1214,6 +1214,28 @@ func TestAccFirehoseDeliveryStream_icebergUpdates(t *testing.T) {
 	})
 }
 
+func TestAccFirehoseDeliveryStream_icebergInvalidBuffering(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FirehoseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeliveryStream_icebergBuffering(rName, 901, 5),
+				ExpectError: regexache.MustCompile(`expected iceberg_configuration.0.buffering_interval to be in the range \(0 - 900\)`),
+			},
+			{
+				Config:      testAccDeliveryStream_icebergBuffering(rName, 300, 129),
+				ExpectError: regexache.MustCompile(`expected iceberg_configuration.0.buffering_size to be in the range \(1 - 128\)`),
+			},
+		},
+	})
+}
+
 func TestAccFirehoseDeliveryStream_icebergUpgradeV6_7_0(t *testing.T) {
 	// In main test account:
 	// "InvalidArgumentException: Role ... is not authorized to perform: glue:GetTable for the given table or the table does not exist."
@@ -4304,6 +4326,35 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName))
 }
 
+func testAccDeliveryStream_icebergBuffering(rName string, interval, size int) string {
+	return acctest.ConfigCompose(
+		testAccDeliveryStreamConfig_baseIceberg(rName),
+		fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose]
+  name        = %[1]q
+  destination = "iceberg"
+
+  iceberg_configuration {
+    role_arn           = aws_iam_role.firehose.arn
+    catalog_arn        = "arn:${data.aws_partition.current.partition}:glue:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:catalog"
+    buffering_interval = %[2]d
+    buffering_size     = %[3]d
+
+    s3_configuration {
+      bucket_arn = aws_s3_bucket.bucket.arn
+      role_arn   = aws_iam_role.firehose.arn
+    }
+
+    destination_table_configuration {
+      database_name = aws_glue_catalog_database.test.name
+      table_name    = aws_glue_catalog_table.test.name
+    }
+  }
+}
+`, rName, interval, size))
+}
+
 func testAccDeliveryStream_icebergUpdatesMetadataProcessor(rName string) string {
 	return acctest.ConfigCompose(
 		testAccDeliveryStreamConfig_baseIceberg(rName),
@@ -12,5 +12,6 @@ var (
 	FindAdminAccount          = findAdminAccount
 	FindPolicyByID            = findPolicyByID
 	FindResourceSetByID       = findResourceSetByID
+	ManagedServiceDataType    = managedServiceDataType
 	RemoveEmptyFieldsFromJSON = removeEmptyFieldsFromJSON
 )
@@ -69,3 +69,17 @@ func removeEmptyFieldsFromJSON(in string) string {
 
 	return string(out)
 }
+
+// managedServiceDataType returns the value of the top-level `type` field of a managed service data JSON string.
+// An empty string is returned if the JSON is invalid or the field is absent.
+func managedServiceDataType(in string) string {
+	var v struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal([]byte(in), &v); err != nil {
+		return ""
+	}
+
+	return v.Type
+}
@@ -69,3 +69,44 @@ func TestRemoveEmptyFieldsFromJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestManagedServiceDataType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		input    string
+		want     string
+	}{
+		{
+			testName: "invalid JSON",
+			input:    "{",
+			want:     "",
+		},
+		{
+			testName: "no type field",
+			input:    `{"key": 42}`,
+			want:     "",
+		},
+		{
+			testName: "NETWORK_ACL_COMMON",
+			input:    `{"type": "NETWORK_ACL_COMMON"}`,
+			want:     "NETWORK_ACL_COMMON",
+		},
+		{
+			testName: "SECURITY_GROUPS_USAGE_AUDIT",
+			input:    `{"type": "SECURITY_GROUPS_USAGE_AUDIT", "deleteUnusedSecurityGroups": true, "coalesceRedundantSecurityGroups": true}`,
+			want:     "SECURITY_GROUPS_USAGE_AUDIT",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tffms.ManagedServiceDataType(testCase.input), testCase.want; got != want {
+				t.Errorf("ManagedServiceDataType(%q) = %q, want %q", testCase.input, got, want)
+			}
+		})
+	}
+}
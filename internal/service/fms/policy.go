@@ -5,6 +5,7 @@ package fms
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -43,6 +44,8 @@ func resourcePolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourcePolicyCustomizeDiff,
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			networkACLEntrySetNestedBlock := func() *schema.Schema {
 				return &schema.Schema{
@@ -306,8 +309,9 @@ func resourcePolicy() *schema.Resource {
 								},
 							},
 							names.AttrType: {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.SecurityServiceType](),
 							},
 						},
 					},
@@ -445,6 +449,31 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return diags
 }
 
+func resourcePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("security_service_policy_data") {
+		return nil
+	}
+
+	v, ok := d.Get("security_service_policy_data").([]any)
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap := v[0].(map[string]any)
+	policyType, managedServiceData := tfMap[names.AttrType].(string), tfMap["managed_service_data"].(string)
+
+	if policyType == "" || managedServiceData == "" {
+		return nil
+	}
+
+	// The managed service data type, when present, must match the policy type.
+	if dataType := managedServiceDataType(managedServiceData); dataType != "" && dataType != policyType {
+		return fmt.Errorf(`security_service_policy_data.managed_service_data type (%s) does not match security_service_policy_data.type (%s)`, dataType, policyType)
+	}
+
+	return nil
+}
+
 func findPolicyByID(ctx context.Context, conn *fms.Client, id string) (*fms.GetPolicyOutput, error) {
 	input := &fms.GetPolicyInput{
 		PolicyId: aws.String(id),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -442,8 +443,19 @@ func testAccPolicy_nacl(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.1.port_range.0.to", "2345"),
 					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.force_remediate_for_first_entries", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.force_remediate_for_last_entries", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "NETWORK_ACL_COMMON"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+			{
+				Config:      testAccPolicyConfig_naclTypeMismatch(rName),
+				ExpectError: regexache.MustCompile(`managed_service_data type \(SECURITY_GROUPS_USAGE_AUDIT\) does not match`),
+			},
 		},
 	})
 }
@@ -1010,3 +1022,23 @@ resource "aws_fms_policy" "test" {
 }
 `, policyName, ruleGroupName))
 }
+
+func testAccPolicyConfig_naclTypeMismatch(policyName string) string {
+	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  depends_on = [aws_fms_admin_account.test]
+
+  exclude_resource_tags = false
+  name                  = %[1]q
+  description           = "test description"
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::Subnet"
+
+  security_service_policy_data {
+    type = "NETWORK_ACL_COMMON"
+
+    managed_service_data = jsonencode({ type = "SECURITY_GROUPS_USAGE_AUDIT" })
+  }
+}
+`, policyName))
+}
@@ -227,6 +227,24 @@ func TestAccGlueCatalogDatabase_federatedDatabase(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogDatabase_federatedAndTargetDatabase(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCatalogDatabaseConfig_federatedAndTarget(rName),
+				ExpectError: regexache.MustCompile(`"federated_database": conflicts with target_database`),
+			},
+		},
+	})
+}
+
 func TestAccGlueCatalogDatabase_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_glue_catalog_database.test"
@@ -462,6 +480,34 @@ resource "aws_glue_catalog_database" "test2" {
 `, rName)
 }
 
+func testAccCatalogDatabaseConfig_federatedAndTarget(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  federated_database {
+    connection_name = "aws:redshift"
+    identifier      = "arn:${data.aws_partition.current.partition}:redshift:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:datashare:00000000-0000-0000-0000-000000000000/%[1]s"
+  }
+
+  target_database {
+    catalog_id    = aws_glue_catalog_database.test2.catalog_id
+    database_name = aws_glue_catalog_database.test2.name
+  }
+}
+
+resource "aws_glue_catalog_database" "test2" {
+  name = "%[1]s-2"
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
+
 func testAccCatalogDatabaseConfig_targetLocation(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
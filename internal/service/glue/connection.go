@@ -52,6 +52,66 @@ func resourceConnection() *schema.Resource {
 				Sensitive: true,
 				Elem:      &schema.Schema{Type: schema.TypeString},
 			},
+			"authentication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AuthenticationType](),
+						},
+						"oauth2_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"oauth2_client_application": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"aws_managed_client_application_reference": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"user_managed_client_application_client_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"oauth2_grant_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.OAuth2GrantType](),
+									},
+									"token_url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"token_url_parameters_map": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"secret_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			names.AttrCatalogID: {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -173,6 +233,9 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta an
 
 	d.Set(names.AttrARN, connectionARN(ctx, c, connectionName))
 	d.Set("athena_properties", connection.AthenaProperties)
+	if err := d.Set("authentication_configuration", flattenAuthenticationConfiguration(connection.AuthenticationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting authentication_configuration: %s", err)
+	}
 	d.Set(names.AttrCatalogID, catalogID)
 	d.Set("connection_properties", connection.ConnectionProperties)
 	d.Set("connection_type", connection.ConnectionType)
@@ -302,6 +365,10 @@ func expandConnectionInput(d *schema.ResourceData) *awstypes.ConnectionInput {
 		apiObject.AthenaProperties = flex.ExpandStringValueMap(v.(map[string]any))
 	}
 
+	if v, ok := d.GetOk("authentication_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		apiObject.AuthenticationConfiguration = expandAuthenticationConfigurationInput(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk("connection_properties"); ok && len(v.(map[string]any)) > 0 {
 		apiObject.ConnectionProperties = flex.ExpandStringValueMap(v.(map[string]any))
 	} else {
@@ -323,6 +390,94 @@ func expandConnectionInput(d *schema.ResourceData) *awstypes.ConnectionInput {
 	return apiObject
 }
 
+func expandAuthenticationConfigurationInput(tfMap map[string]any) *awstypes.AuthenticationConfigurationInput {
+	apiObject := &awstypes.AuthenticationConfigurationInput{
+		AuthenticationType: awstypes.AuthenticationType(tfMap["authentication_type"].(string)),
+	}
+
+	if v, ok := tfMap["oauth2_properties"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OAuth2Properties = expandOAuth2PropertiesInput(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOAuth2PropertiesInput(tfMap map[string]any) *awstypes.OAuth2PropertiesInput {
+	apiObject := &awstypes.OAuth2PropertiesInput{
+		OAuth2GrantType: awstypes.OAuth2GrantType(tfMap["oauth2_grant_type"].(string)),
+	}
+
+	if v, ok := tfMap["oauth2_client_application"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OAuth2ClientApplication = expandOAuth2ClientApplication(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["token_url"].(string); ok && v != "" {
+		apiObject.TokenUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["token_url_parameters_map"].(map[string]any); ok && len(v) > 0 {
+		apiObject.TokenUrlParametersMap = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandOAuth2ClientApplication(tfMap map[string]any) *awstypes.OAuth2ClientApplication {
+	apiObject := &awstypes.OAuth2ClientApplication{}
+
+	if v, ok := tfMap["aws_managed_client_application_reference"].(string); ok && v != "" {
+		apiObject.AWSManagedClientApplicationReference = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_managed_client_application_client_id"].(string); ok && v != "" {
+		apiObject.UserManagedClientApplicationClientId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAuthenticationConfiguration(apiObject *awstypes.AuthenticationConfiguration) []any {
+	if apiObject == nil {
+		return []any{}
+	}
+
+	tfMap := map[string]any{
+		"authentication_type": apiObject.AuthenticationType,
+		"secret_arn":          aws.ToString(apiObject.SecretArn),
+	}
+
+	if v := apiObject.OAuth2Properties; v != nil {
+		tfMap["oauth2_properties"] = flattenOAuth2Properties(v)
+	}
+
+	return []any{tfMap}
+}
+
+func flattenOAuth2Properties(apiObject *awstypes.OAuth2Properties) []any {
+	if apiObject == nil {
+		return []any{}
+	}
+
+	tfMap := map[string]any{
+		"oauth2_grant_type":        apiObject.OAuth2GrantType,
+		"token_url":                aws.ToString(apiObject.TokenUrl),
+		"token_url_parameters_map": apiObject.TokenUrlParametersMap,
+	}
+
+	if v := apiObject.OAuth2ClientApplication; v != nil {
+		tfMap["oauth2_client_application"] = []any{map[string]any{
+			"aws_managed_client_application_reference":  aws.ToString(v.AWSManagedClientApplicationReference),
+			"user_managed_client_application_client_id": aws.ToString(v.UserManagedClientApplicationClientId),
+		}}
+	}
+
+	return []any{tfMap}
+}
+
 func expandPhysicalConnectionRequirements(tfMap map[string]any) *awstypes.PhysicalConnectionRequirements {
 	apiObject := &awstypes.PhysicalConnectionRequirements{}
 
@@ -565,6 +565,77 @@ func TestAccGlueConnection_snowflake(t *testing.T) {
 	})
 }
 
+func TestAccGlueConnection_snowflakeAuthenticationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connection awstypes.Connection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_connection.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+	sfUrl := "https://" + rName + ".snowflakecomputing.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_snowflakeAuthenticationConfiguration(rName, sfUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.authentication_type", "BASIC"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "authentication_configuration.0.secret_arn", secretResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "SNOWFLAKE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueConnection_salesforceOAuth2AuthenticationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connection awstypes.Connection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_connection.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_salesforceOAuth2AuthenticationConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.authentication_type", "OAUTH2"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.0.oauth2_grant_type", "JWT_BEARER"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.0.token_url", "https://login.salesforce.com/services/oauth2/token"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.0.token_url_parameters_map.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.0.token_url_parameters_map.grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer"),
+					resource.TestCheckResourceAttrPair(resourceName, "authentication_configuration.0.secret_arn", secretResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "SALESFORCE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckConnectionExists(ctx context.Context, n string, v *awstypes.Connection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1063,6 +1134,81 @@ resource "aws_glue_connection" "test" {
 `, rName, sfUrl)
 }
 
+func testAccConnectionConfig_snowflakeAuthenticationConfiguration(rName, sfUrl string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    USERNAME = "testusername"
+    PASSWORD = "testpassword"
+  })
+}
+
+resource "aws_glue_connection" "test" {
+  name = %[1]q
+
+  connection_type = "SNOWFLAKE"
+  connection_properties = {
+    SparkProperties = jsonencode({
+      sfRole = "TESTETLROLE"
+      sfUrl  = %[2]q
+    })
+  }
+
+  authentication_configuration {
+    authentication_type = "BASIC"
+    secret_arn          = aws_secretsmanager_secret.test.arn
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, rName, sfUrl)
+}
+
+func testAccConnectionConfig_salesforceOAuth2AuthenticationConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    JWT_TOKEN = "testjwttoken"
+  })
+}
+
+resource "aws_glue_connection" "test" {
+  name = %[1]q
+
+  connection_type = "SALESFORCE"
+  connection_properties = {
+    INSTANCE_URL = "https://%[1]s.my.salesforce.com"
+  }
+
+  authentication_configuration {
+    authentication_type = "OAUTH2"
+    secret_arn          = aws_secretsmanager_secret.test.arn
+
+    oauth2_properties {
+      oauth2_grant_type = "JWT_BEARER"
+      token_url         = "https://login.salesforce.com/services/oauth2/token"
+
+      token_url_parameters_map = {
+        grant_type = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+      }
+    }
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, rName)
+}
+
 func testAccConnectionConfig_dynamoDB(rName, region, bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -67,9 +67,12 @@ func resourceDataQualityRuleset() *schema.Resource {
 				Computed: true,
 			},
 			"ruleset": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 65536),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 65536),
+					validation.StringIsNotWhiteSpace,
+				),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -183,11 +186,8 @@ func resourceDataQualityRulesetUpdate(ctx context.Context, d *schema.ResourceDat
 		name := d.Id()
 
 		input := &glue.UpdateDataQualityRulesetInput{
-			Name: aws.String(name),
-		}
-
-		if v, ok := d.GetOk(names.AttrDescription); ok {
-			input.Description = aws.String(v.(string))
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			Name:        aws.String(name),
 		}
 
 		if v, ok := d.GetOk("ruleset"); ok {
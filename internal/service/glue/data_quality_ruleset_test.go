@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -92,6 +93,25 @@ func TestAccGlueDataQualityRuleset_updateRuleset(t *testing.T) {
 	})
 }
 
+func TestAccGlueDataQualityRuleset_emptyRuleset(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataQualityRulesetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataQualityRulesetConfig_basic(rName, "  "),
+				ExpectError: regexache.MustCompile(`expected "ruleset" to not be an empty string`),
+			},
+		},
+	})
+}
+
 func TestAccGlueDataQualityRuleset_updateDescription(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -126,6 +146,13 @@ func TestAccGlueDataQualityRuleset_updateDescription(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, updatedDescription),
 				),
 			},
+			{
+				Config: testAccDataQualityRulesetConfig_basic(rName, ruleset),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+				),
+			},
 		},
 	})
 }
@@ -41,6 +41,22 @@ func resourceWorkflow() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"blueprint_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blueprint_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"run_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"default_run_properties": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -91,7 +107,7 @@ func resourceWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta an
 	log.Printf("[DEBUG] Creating Glue Workflow: %+v", input)
 	_, err := conn.CreateWorkflow(ctx, input)
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Glue Trigger (%s): %s", name, err)
+		return sdkdiag.AppendErrorf(diags, "creating Glue Workflow (%s): %s", name, err)
 	}
 	d.SetId(name)
 
@@ -133,6 +149,9 @@ func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}.String()
 	d.Set(names.AttrARN, workFlowArn)
 
+	if err := d.Set("blueprint_details", flattenWorkflowBlueprintDetails(workflow.BlueprintDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting blueprint_details: %s", err)
+	}
 	if err := d.Set("default_run_properties", workflow.DefaultRunProperties); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting default_run_properties: %s", err)
 	}
@@ -148,16 +167,11 @@ func resourceWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	conn := meta.(*conns.AWSClient).GlueClient(ctx)
 
 	if d.HasChanges("default_run_properties", names.AttrDescription, "max_concurrent_runs") {
+		// Always send the run properties and description so that removing them clears the remote values.
 		input := &glue.UpdateWorkflowInput{
-			Name: aws.String(d.Get(names.AttrName).(string)),
-		}
-
-		if kv, ok := d.GetOk("default_run_properties"); ok {
-			input.DefaultRunProperties = flex.ExpandStringValueMap(kv.(map[string]any))
-		}
-
-		if v, ok := d.GetOk(names.AttrDescription); ok {
-			input.Description = aws.String(v.(string))
+			DefaultRunProperties: flex.ExpandStringValueMap(d.Get("default_run_properties").(map[string]any)),
+			Description:          aws.String(d.Get(names.AttrDescription).(string)),
+			Name:                 aws.String(d.Get(names.AttrName).(string)),
 		}
 
 		if v, ok := d.GetOk("max_concurrent_runs"); ok {
@@ -202,3 +216,16 @@ func deleteWorkflow(ctx context.Context, conn *glue.Client, name string) error {
 
 	return nil
 }
+
+func flattenWorkflowBlueprintDetails(apiObject *awstypes.BlueprintDetails) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"blueprint_name": aws.ToString(apiObject.BlueprintName),
+		"run_id":         aws.ToString(apiObject.RunId),
+	}
+
+	return []any{tfMap}
+}
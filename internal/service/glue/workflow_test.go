@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -40,6 +41,7 @@ func TestAccGlueWorkflow_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckWorkflowExists(ctx, resourceName, &workflow),
 					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "glue", fmt.Sprintf("workflow/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "blueprint_details.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 				),
@@ -123,6 +125,27 @@ func TestAccGlueWorkflow_defaultRunProperties(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccWorkflowConfig_defaultRunProperties(rName, "firstPropValueUpdated", "secondPropValue"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.--run-prop1", "firstPropValueUpdated"),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.--run-prop2", "secondPropValue"),
+				),
+			},
+			{
+				Config: testAccWorkflowConfig_required(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.%", "0"),
+				),
+			},
 		},
 	})
 }
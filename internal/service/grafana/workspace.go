@@ -112,16 +112,18 @@ func resourceWorkspace() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"prefix_list_ids": {
-							Type:     schema.TypeSet,
-							Required: true,
-							MaxItems: 100,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:         schema.TypeSet,
+							Optional:     true,
+							MaxItems:     100,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							AtLeastOneOf: []string{"network_access_control.0.prefix_list_ids", "network_access_control.0.vpce_ids"},
 						},
 						"vpce_ids": {
-							Type:     schema.TypeSet,
-							Required: true,
-							MaxItems: 100,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:         schema.TypeSet,
+							Optional:     true,
+							MaxItems:     100,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							AtLeastOneOf: []string{"network_access_control.0.prefix_list_ids", "network_access_control.0.vpce_ids"},
 						},
 					},
 				},
@@ -584,7 +586,11 @@ func expandNetworkAccessControl(tfList []any) *awstypes.NetworkAccessConfigurati
 	}
 
 	tfMap := tfList[0].(map[string]any)
-	apiObject := awstypes.NetworkAccessConfiguration{}
+	// Both lists are required by the API, even if empty.
+	apiObject := awstypes.NetworkAccessConfiguration{
+		PrefixListIds: []string{},
+		VpceIds:       []string{},
+	}
 
 	if v, ok := tfMap["prefix_list_ids"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.PrefixListIds = flex.ExpandStringValueSet(v)
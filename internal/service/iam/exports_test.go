@@ -71,6 +71,7 @@ var (
 	ListGroupsForUserPages            = listGroupsForUserPages
 	RoleNameSessionFromARN            = roleNameSessionFromARN
 	RolePolicyParseID                 = rolePolicyParseID
+	RoleUpdateTags                    = roleUpdateTags
 	ServiceLinkedRoleParseResourceID  = serviceLinkedRoleParseResourceID
 	SESSMTPPasswordFromSecretKeySigV4 = sesSMTPPasswordFromSecretKeySigV4
 )
@@ -326,6 +326,48 @@ func TestAccIAMRole_disappears(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_tags_ignoreTagsKeyPrefixes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role awstypes.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					testAccCheckRoleUpdateTags(ctx, &role, nil, map[string]string{"ignorekey1": "ignorevalue1", "ignorekey2": "ignorevalue2"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccRoleConfig_basic(rName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_policiesForceDetach(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Role
@@ -1092,6 +1134,14 @@ func testAccCheckRoleExists(ctx context.Context, n string, v *awstypes.Role) res
 	}
 }
 
+func testAccCheckRoleUpdateTags(ctx context.Context, role *awstypes.Role, oldTags, newTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		return tfiam.RoleUpdateTags(ctx, conn, aws.ToString(role.RoleName), oldTags, newTags)
+	}
+}
+
 // Attach inline policy out of band (outside of terraform)
 func testAccAddRolePolicy(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
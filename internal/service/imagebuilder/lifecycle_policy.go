@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -296,6 +297,63 @@ func (r *lifecyclePolicyResource) Schema(ctx context.Context, request resource.S
 	}
 }
 
+func (r *lifecyclePolicyResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data lifecyclePolicyResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PolicyDetails.IsNull() || data.PolicyDetails.IsUnknown() {
+		return
+	}
+
+	policyDetails, diags := data.PolicyDetails.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, policyDetail := range policyDetails {
+		if policyDetail == nil || policyDetail.Filter.IsNull() || policyDetail.Filter.IsUnknown() {
+			continue
+		}
+
+		filter, diags := policyDetail.Filter.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() || filter == nil || filter.Type.IsUnknown() {
+			continue
+		}
+
+		// Time-based filters need a unit, count-based filters must not have one.
+		switch filter.Type.ValueEnum() {
+		case awstypes.LifecyclePolicyDetailFilterTypeAge:
+			if filter.Unit.IsNull() {
+				response.Diagnostics.AddAttributeError(
+					path.Root("policy_detail"),
+					"Missing Attribute Configuration",
+					fmt.Sprintf("filter.unit must be configured when filter.type is %q", awstypes.LifecyclePolicyDetailFilterTypeAge),
+				)
+			}
+		case awstypes.LifecyclePolicyDetailFilterTypeCount:
+			if !filter.Unit.IsNull() {
+				response.Diagnostics.AddAttributeError(
+					path.Root("policy_detail"),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("filter.unit cannot be configured when filter.type is %q", awstypes.LifecyclePolicyDetailFilterTypeCount),
+				)
+			}
+			if !filter.RetainAtLeast.IsNull() {
+				response.Diagnostics.AddAttributeError(
+					path.Root("policy_detail"),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("filter.retain_at_least cannot be configured when filter.type is %q", awstypes.LifecyclePolicyDetailFilterTypeCount),
+				)
+			}
+		}
+	}
+}
+
 func (r *lifecyclePolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data lifecyclePolicyResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -211,6 +212,50 @@ func TestAccImageBuilderLifecyclePolicy_resourceSelection(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderLifecyclePolicy_filterCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyConfig_filter(rName, "AGE", 6, "null", "null"),
+				ExpectError: regexache.MustCompile(`filter.unit must be configured`),
+			},
+			{
+				Config:      testAccLifecyclePolicyConfig_filter(rName, "COUNT", 5, `"DAYS"`, "null"),
+				ExpectError: regexache.MustCompile(`filter.unit cannot be configured`),
+			},
+			{
+				Config:      testAccLifecyclePolicyConfig_filter(rName, "COUNT", 5, "null", "2"),
+				ExpectError: regexache.MustCompile(`filter.retain_at_least cannot be configured`),
+			},
+			{
+				Config: testAccLifecyclePolicyConfig_filter(rName, "COUNT", 5, "null", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.type", string(awstypes.LifecyclePolicyDetailFilterTypeCount)),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.value", "5"),
+					resource.TestCheckNoResourceAttr(resourceName, "policy_detail.0.filter.0.unit"),
+					resource.TestCheckNoResourceAttr(resourceName, "policy_detail.0.filter.0.retain_at_least"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderLifecyclePolicy_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -401,6 +446,34 @@ resource "aws_imagebuilder_lifecycle_policy" "test" {
 `, rName))
 }
 
+func testAccLifecyclePolicyConfig_filter(rName, filterType string, value int, unit, retainAtLeast string) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyConfig_base(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_lifecycle_policy" "test" {
+  name           = %[1]q
+  execution_role = aws_iam_role.test.arn
+  resource_type  = "AMI_IMAGE"
+  policy_detail {
+    action {
+      type = "DELETE"
+    }
+    filter {
+      type            = %[2]q
+      value           = %[3]d
+      unit            = %[4]s
+      retain_at_least = %[5]s
+    }
+  }
+  resource_selection {
+    tag_map = {
+      "key1" = "value1"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, filterType, value, unit, retainAtLeast))
+}
+
 func testAccLifecyclePolicyConfig_policyDetails(rName string) string {
 	return acctest.ConfigCompose(testAccLifecyclePolicyConfig_base(rName), fmt.Sprintf(`
 resource "aws_imagebuilder_lifecycle_policy" "test" {
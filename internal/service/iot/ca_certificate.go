@@ -5,6 +5,8 @@ package iot
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"time"
@@ -37,6 +39,10 @@ func resourceCACertificate() *schema.Resource {
 		UpdateWithoutTimeout: resourceCACertificateUpdate,
 		DeleteWithoutTimeout: resourceCACertificateDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"active": {
 				Type:     schema.TypeBool,
@@ -98,6 +104,10 @@ func resourceCACertificate() *schema.Resource {
 					},
 				},
 			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"validity": {
@@ -157,6 +167,12 @@ func resourceCACertificateCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if v, ok := d.GetOk("verification_certificate_pem"); ok {
+		// Fail fast with a clear error rather than the generic API validation error
+		// if the verification certificate wasn't issued for this account's registration code.
+		if err := checkVerificationCertificateCommonName(ctx, conn, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "registering IoT CA Certificate: %s", err)
+		}
+
 		input.VerificationCertificate = aws.String(v.(string))
 	}
 
@@ -197,6 +213,7 @@ func resourceCACertificateRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("certificate_mode", certificateDescription.CertificateMode)
 	d.Set("customer_version", certificateDescription.CustomerVersion)
 	d.Set("generation_id", certificateDescription.GenerationId)
+	d.Set(names.AttrStatus, certificateDescription.Status)
 	if output.RegistrationConfig != nil {
 		if err := d.Set("registration_config", []any{flattenRegistrationConfig(output.RegistrationConfig)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting registration_config: %s", err)
@@ -314,6 +331,33 @@ func findCACertificateByID(ctx context.Context, conn *iot.Client, id string) (*i
 	return output, nil
 }
 
+// checkVerificationCertificateCommonName verifies that the subject common name of the
+// specified PEM-encoded verification certificate is the account's IoT registration code.
+// Certificates that cannot be parsed are left for the API to reject.
+func checkVerificationCertificateCommonName(ctx context.Context, conn *iot.Client, verificationCertificatePEM string) error {
+	block, _ := pem.Decode([]byte(verificationCertificatePEM))
+	if block == nil {
+		return nil
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+
+	output, err := conn.GetRegistrationCode(ctx, &iot.GetRegistrationCodeInput{})
+
+	if err != nil {
+		return fmt.Errorf("reading IoT Registration Code: %w", err)
+	}
+
+	if commonName, registrationCode := certificate.Subject.CommonName, aws.ToString(output.RegistrationCode); commonName != registrationCode {
+		return fmt.Errorf("verification certificate subject common name (%s) does not match the IoT registration code (%s)", commonName, registrationCode)
+	}
+
+	return nil
+}
+
 func expandRegistrationConfig(tfMap map[string]any) *awstypes.RegistrationConfig {
 	if tfMap == nil {
 		return nil
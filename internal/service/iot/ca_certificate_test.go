@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -41,12 +42,18 @@ func TestAccIoTCACertificate_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "customer_version"),
 					resource.TestCheckResourceAttrSet(resourceName, "generation_id"),
 					resource.TestCheckResourceAttr(resourceName, "registration_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 					resource.TestCheckResourceAttr(resourceName, "validity.#", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "validity.0.not_after"),
 					resource.TestCheckResourceAttrSet(resourceName, "validity.0.not_before"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -145,24 +152,56 @@ func TestAccIoTCACertificate_defaultMode(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "customer_version"),
 					resource.TestCheckResourceAttrSet(resourceName, "generation_id"),
 					resource.TestCheckResourceAttr(resourceName, "registration_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "INACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 					resource.TestCheckResourceAttr(resourceName, "validity.#", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "validity.0.not_after"),
 					resource.TestCheckResourceAttrSet(resourceName, "validity.0.not_before"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verification_certificate_pem"},
+			},
 			{
 				Config: testAccCACertificateConfig_defaultMode(true, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCACertificateExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "allow_auto_registration", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccIoTCACertificate_verificationCertificateMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	testExternalProviders := map[string]resource.ExternalProvider{
+		"tls": {
+			Source:            "hashicorp/tls",
+			VersionConstraint: "4.0.4",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders:        testExternalProviders,
+		CheckDestroy:             testAccCheckCACertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCACertificateConfig_verificationCommonName(false, false, `"not-the-registration-code"`),
+				ExpectError: regexache.MustCompile(`does not match the IoT registration code`),
+			},
+		},
+	})
+}
+
 func TestAccIoTCACertificate_registrationConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_iot_ca_certificate.test"
@@ -277,6 +316,10 @@ resource "aws_iot_ca_certificate" "test" {
 }
 
 func testAccCACertificateConfig_defaultMode(active, allowAutoRegistration bool) string {
+	return testAccCACertificateConfig_verificationCommonName(active, allowAutoRegistration, "data.aws_iot_registration_code.test.registration_code")
+}
+
+func testAccCACertificateConfig_verificationCommonName(active, allowAutoRegistration bool, commonName string) string {
 	return fmt.Sprintf(`
 resource "tls_self_signed_cert" "ca" {
   private_key_pem = tls_private_key.ca.private_key_pem
@@ -300,7 +343,7 @@ resource "tls_private_key" "ca" {
 resource "tls_cert_request" "verification" {
   private_key_pem = tls_private_key.verification.private_key_pem
   subject {
-    common_name = data.aws_iot_registration_code.test.registration_code
+    common_name = %[3]s
   }
 }
 
@@ -329,7 +372,7 @@ resource "aws_iot_ca_certificate" "test" {
 }
 
 data "aws_iot_registration_code" "test" {}
-`, active, allowAutoRegistration)
+`, active, allowAutoRegistration, commonName)
 }
 
 func testAccCACertificateConfig_registrationConfig_iamRole() string {
@@ -6,6 +6,7 @@ package kms
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"slices"
@@ -55,6 +56,16 @@ func resourceGrant() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+			if v, ok := d.GetOk("constraints"); ok && v.(*schema.Set).Len() > 0 {
+				if !grantConstraintsIsValid(v.(*schema.Set)) {
+					return errors.New("A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
+				}
+			}
+
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"constraints": {
 				Type:     schema.TypeSet,
@@ -67,14 +78,14 @@ func resourceGrant() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
-							// ConflictsWith encryption_context_subset handled in Create, see grantConstraintsIsValid
+							// ConflictsWith encryption_context_subset handled in CustomizeDiff, see grantConstraintsIsValid
 						},
 						"encryption_context_subset": {
 							Type:     schema.TypeMap,
 							Optional: true,
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
-							// ConflictsWith encryption_context_equals handled in Create, see grantConstraintsIsValid
+							// ConflictsWith encryption_context_equals handled in CustomizeDiff, see grantConstraintsIsValid
 						},
 					},
 				},
@@ -155,10 +166,6 @@ func resourceGrantCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if v, ok := d.GetOk("constraints"); ok && v.(*schema.Set).Len() > 0 {
-		if !grantConstraintsIsValid(v.(*schema.Set)) {
-			return sdkdiag.AppendErrorf(diags, "A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
-		}
-
 		input.Constraints = expandGrantConstraints(v.(*schema.Set))
 	}
 
@@ -245,10 +252,16 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta any)
 
 	if d.Get("retire_on_delete").(bool) {
 		log.Printf("[DEBUG] Retiring KMS Grant: %s", d.Id())
-		_, err = conn.RetireGrant(ctx, &kms.RetireGrantInput{
-			GrantId: aws.String(grantID),
-			KeyId:   aws.String(keyID),
-		})
+		input := kms.RetireGrantInput{}
+		// Prefer the grant token when it's known as the grant may not yet be eventually consistent.
+		// The token isn't available for imported grants, so fall back to the key and grant IDs.
+		if v, ok := d.GetOk("grant_token"); ok {
+			input.GrantToken = aws.String(v.(string))
+		} else {
+			input.GrantId = aws.String(grantID)
+			input.KeyId = aws.String(keyID)
+		}
+		_, err = conn.RetireGrant(ctx, &input)
 	} else {
 		log.Printf("[DEBUG] Revoking KMS Grant: %s", d.Id())
 		_, err = conn.RevokeGrant(ctx, &kms.RevokeGrantInput{
@@ -396,12 +409,12 @@ func expandGrantConstraints(tfSet *schema.Set) *awstypes.GrantConstraints {
 	for _, tfMapRaw := range tfSet.List() {
 		tfMap := tfMapRaw.(map[string]any)
 
-		if v, ok := tfMap["encryption_context_equals"]; ok {
-			apiObject.EncryptionContextEquals = flex.ExpandStringValueMap(v.(map[string]any))
+		if v, ok := tfMap["encryption_context_equals"].(map[string]any); ok && len(v) > 0 {
+			apiObject.EncryptionContextEquals = flex.ExpandStringValueMap(v)
 		}
 
-		if v, ok := tfMap["encryption_context_subset"]; ok {
-			apiObject.EncryptionContextSubset = flex.ExpandStringValueMap(v.(map[string]any))
+		if v, ok := tfMap["encryption_context_subset"].(map[string]any); ok && len(v) > 0 {
+			apiObject.EncryptionContextSubset = flex.ExpandStringValueMap(v)
 		}
 	}
 
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -100,6 +102,46 @@ func TestAccKMSGrant_withConstraints(t *testing.T) {
 	})
 }
 
+func TestAccKMSGrant_constraintsSubset(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGrantConfig_constraintsConflict(rName),
+				ExpectError: regexache.MustCompile(`can't have both encryption_context_equals and encryption_context_subset set`),
+			},
+			{
+				Config: testAccGrantConfig_constraints(rName, "encryption_context_subset", `Department = "Finance"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "constraints.*", map[string]string{
+						"encryption_context_subset.%":          "1",
+						"encryption_context_subset.Department": "Finance",
+					}),
+				),
+			},
+			{
+				Config:   testAccGrantConfig_constraints(rName, "encryption_context_subset", `Department = "Finance"`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+			},
+		},
+	})
+}
+
 func TestAccKMSGrant_withRetiringPrincipal(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_kms_grant.test"
@@ -419,6 +461,26 @@ resource "aws_kms_grant" "test" {
 `, rName, constraintName, encryptionContext))
 }
 
+func testAccGrantConfig_constraintsConflict(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_grant" "test" {
+  name              = %[1]q
+  key_id            = aws_kms_key.test.key_id
+  grantee_principal = aws_iam_role.test.arn
+  operations        = ["RetireGrant", "DescribeKey"]
+
+  constraints {
+    encryption_context_equals = {
+      Department = "Finance"
+    }
+    encryption_context_subset = {
+      Department = "Finance"
+    }
+  }
+}
+`, rName))
+}
+
 func testAccGrantConfig_retiringPrincipal(rName string) string {
 	return acctest.ConfigCompose(testAccGrantConfig_base(rName), fmt.Sprintf(`
 resource "aws_kms_grant" "test" {
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	iamwait "github.com/hashicorp/terraform-provider-aws/internal/iam/wait"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -127,23 +128,11 @@ func resourceFunctionEventInvokeConfigCreate(ctx context.Context, d *schema.Reso
 	}
 
 	// Retry for destination validation eventual consistency errors.
-	_, err := tfresource.RetryWhen(ctx, iamPropagationTimeout,
-		func(ctx context.Context) (any, error) {
+	_, err := iamwait.RetryWhenNotPropagated(ctx,
+		func(ctx context.Context) (*lambda.PutFunctionEventInvokeConfigOutput, error) {
 			return conn.PutFunctionEventInvokeConfig(ctx, input)
 		},
-		func(err error) (bool, error) {
-			// InvalidParameterValueException: The destination ARN arn:PARTITION:SERVICE:REGION:ACCOUNT:RESOURCE is invalid.
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "destination ARN") {
-				return true, err
-			}
-
-			// InvalidParameterValueException: The function's execution role does not have permissions to call Publish on arn:...
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "does not have permissions") {
-				return true, err
-			}
-
-			return false, err
-		},
+		isFunctionEventInvokeConfigDestinationNotPropagatedError,
 	)
 
 	if err != nil {
@@ -211,23 +200,11 @@ func resourceFunctionEventInvokeConfigUpdate(ctx context.Context, d *schema.Reso
 	}
 
 	// Retry for destination validation eventual consistency errors.
-	_, err = tfresource.RetryWhen(ctx, iamPropagationTimeout,
-		func(ctx context.Context) (any, error) {
+	_, err = iamwait.RetryWhenNotPropagated(ctx,
+		func(ctx context.Context) (*lambda.PutFunctionEventInvokeConfigOutput, error) {
 			return conn.PutFunctionEventInvokeConfig(ctx, input)
 		},
-		func(err error) (bool, error) {
-			// InvalidParameterValueException: The destination ARN arn:PARTITION:SERVICE:REGION:ACCOUNT:RESOURCE is invalid.
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "destination ARN") {
-				return true, err
-			}
-
-			// InvalidParameterValueException: The function's execution role does not have permissions to call Publish on arn:...
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "does not have permissions") {
-				return true, err
-			}
-
-			return false, err
-		},
+		isFunctionEventInvokeConfigDestinationNotPropagatedError,
 	)
 
 	if err != nil {
@@ -449,3 +426,10 @@ func flattenFunctionEventInvokeConfigDestinationConfigOnSuccess(apiObject *awsty
 
 	return []any{m}
 }
+
+func isFunctionEventInvokeConfigDestinationNotPropagatedError(err error) bool {
+	// InvalidParameterValueException: The destination ARN arn:PARTITION:SERVICE:REGION:ACCOUNT:RESOURCE is invalid.
+	// InvalidParameterValueException: The function's execution role does not have permissions to call Publish on arn:...
+	return errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "destination ARN") ||
+		errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "does not have permissions")
+}
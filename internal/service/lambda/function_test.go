@@ -119,6 +119,41 @@ func TestAccLambdaFunction_disappears(t *testing.T) {
 	})
 }
 
+// Tags must be applied by CreateFunction itself so that they are present as soon as
+// the function exists, including any provider default tags.
+func TestAccLambdaFunction_tagsOnCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccFunctionConfig_tagsOnCreate(rName, acctest.CtKey1, acctest.CtValue1),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionTags(&conf, map[string]string{
+						acctest.CtKey1: acctest.CtValue1,
+						"providerkey1": "providervalue1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2559,6 +2594,18 @@ func testAccCheckFunctionExists(ctx context.Context, n string, v *lambda.GetFunc
 	}
 }
 
+func testAccCheckFunctionTags(function *lambda.GetFunctionOutput, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for k, v := range expected {
+			if got, ok := function.Tags[k]; !ok || got != v {
+				return fmt.Errorf("Lambda Function (%s) tag %q: expected %q, got %q", aws.ToString(function.Configuration.FunctionName), k, v, got)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFunctionQualifiedInvokeARN(name string, function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		qualifiedArn := fmt.Sprintf("%s:%s", aws.ToString(function.Configuration.FunctionArn), aws.ToString(function.Configuration.Version))
@@ -2770,6 +2817,24 @@ resource "aws_lambda_function" "test" {
 `, funcName))
 }
 
+func testAccFunctionConfig_tagsOnCreate(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
 func testAccFunctionConfig_snapStartEnabled(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -206,6 +207,23 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		input.CompatibleRuntimes = flex.ExpandStringyValueSet[awstypes.Runtime](v.(*schema.Set))
 	}
 
+	// If the latest published version already has identical content and settings, adopt it instead of publishing a duplicate.
+	// Only retained versions are adopted, as otherwise destroying this resource would delete a version it did not publish.
+	if v, ok := d.GetOk("source_code_hash"); ok && d.Get(names.AttrSkipDestroy).(bool) {
+		latest, err := findLatestLayerVersionByName(ctx, conn, layerName)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading latest Lambda Layer (%s) Version: %s", layerName, err)
+		case layerVersionMatchesPublishInput(latest, input, v.(string)):
+			log.Printf("[INFO] Lambda Layer (%s) Version %d matches source_code_hash, not publishing a new version", layerName, latest.Version)
+			d.SetId(aws.ToString(latest.LayerVersionArn))
+
+			return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
+		}
+	}
+
 	output, err := conn.PublishLayerVersion(ctx, input)
 
 	if err != nil {
@@ -328,3 +346,45 @@ func findLayerVersion(ctx context.Context, conn *lambda.Client, input *lambda.Ge
 
 	return output, nil
 }
+
+func findLatestLayerVersionByName(ctx context.Context, conn *lambda.Client, layerName string) (*lambda.GetLayerVersionOutput, error) {
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+		MaxItems:  aws.Int32(1),
+	}
+
+	output, err := conn.ListLayerVersions(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LayerVersions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return findLayerVersionByTwoPartKey(ctx, conn, layerName, output.LayerVersions[0].Version)
+}
+
+func layerVersionMatchesPublishInput(output *lambda.GetLayerVersionOutput, input *lambda.PublishLayerVersionInput, sourceCodeHash string) bool {
+	if output.Content == nil || aws.ToString(output.Content.CodeSha256) != sourceCodeHash {
+		return false
+	}
+
+	if aws.ToString(output.Description) != aws.ToString(input.Description) || aws.ToString(output.LicenseInfo) != aws.ToString(input.LicenseInfo) {
+		return false
+	}
+
+	if !slices.Equal(slices.Sorted(slices.Values(output.CompatibleArchitectures)), slices.Sorted(slices.Values(input.CompatibleArchitectures))) {
+		return false
+	}
+
+	return slices.Equal(slices.Sorted(slices.Values(output.CompatibleRuntimes)), slices.Sorted(slices.Values(input.CompatibleRuntimes)))
+}
@@ -3,14 +3,17 @@ package lambda
 
 import (
 	"context"
+	"time"
 
 	"github.com/YakDriver/smarterr"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -23,7 +26,12 @@ func listTags(ctx context.Context, conn *lambda.Client, identifier string, optFn
 		Resource: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(ctx, &input, optFns...)
+	output, err := tfresource.RetryWhenIsAErrorMessageContains[*lambda.ListTagsOutput, *awstypes.ResourceConflictException](ctx, 5*time.Minute,
+		func(ctx context.Context) (*lambda.ListTagsOutput, error) {
+			return conn.ListTags(ctx, &input, optFns...)
+		},
+		"The operation cannot be performed at this time",
+	)
 
 	if err != nil {
 		return tftags.New(ctx, nil), smarterr.NewError(err)
@@ -96,7 +104,12 @@ func updateTags(ctx context.Context, conn *lambda.Client, identifier string, old
 			TagKeys:  removedTags.Keys(),
 		}
 
-		_, err := conn.UntagResource(ctx, &input, optFns...)
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[any, *awstypes.ResourceConflictException](ctx, 5*time.Minute,
+			func(ctx context.Context) (any, error) {
+				return conn.UntagResource(ctx, &input, optFns...)
+			},
+			"The operation cannot be performed at this time",
+		)
 
 		if err != nil {
 			return smarterr.NewError(err)
@@ -111,7 +124,12 @@ func updateTags(ctx context.Context, conn *lambda.Client, identifier string, old
 			Tags:     svcTags(updatedTags),
 		}
 
-		_, err := conn.TagResource(ctx, &input, optFns...)
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[any, *awstypes.ResourceConflictException](ctx, 5*time.Minute,
+			func(ctx context.Context) (any, error) {
+				return conn.TagResource(ctx, &input, optFns...)
+			},
+			"The operation cannot be performed at this time",
+		)
 
 		if err != nil {
 			return smarterr.NewError(err)
@@ -0,0 +1,523 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lexv2models_custom_vocabulary", name="Custom Vocabulary")
+func newCustomVocabularyResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &customVocabularyResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type customVocabularyResource struct {
+	framework.ResourceWithModel[customVocabularyResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *customVocabularyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"locale_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"custom_vocabulary_item": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[customVocabularyItemModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeBetween(1, 500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"display_as": schema.StringAttribute{
+							Optional: true,
+						},
+						"phrase": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrWeight: schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 3),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+const (
+	customVocabularyResourceIDPartCount = 3
+)
+
+func (r *customVocabularyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customVocabularyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	localeID, botID, botVersion := fwflex.StringValueFromFramework(ctx, data.LocaleID), fwflex.StringValueFromFramework(ctx, data.BotID), fwflex.StringValueFromFramework(ctx, data.BotVersion)
+	id, _ := intflex.FlattenResourceId([]string{localeID, botID, botVersion}, customVocabularyResourceIDPartCount, false)
+
+	var items []awstypes.NewCustomVocabularyItem
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.CustomVocabularyItems, &items)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := lexmodelsv2.BatchCreateCustomVocabularyItemInput{
+		BotId:                    aws.String(botID),
+		BotVersion:               aws.String(botVersion),
+		CustomVocabularyItemList: items,
+		LocaleId:                 aws.String(localeID),
+	}
+	output, err := conn.BatchCreateCustomVocabularyItem(ctx, &input)
+
+	if err == nil {
+		err = failedCustomVocabularyItemsError(output.Errors)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lex v2 Custom Vocabulary (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	if _, err := waitCustomVocabularyReady(ctx, conn, localeID, botID, botVersion, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Custom Vocabulary (%s) create", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *customVocabularyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customVocabularyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	parts, err := intflex.ExpandResourceId(id, customVocabularyResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	localeID, botID, botVersion := parts[0], parts[1], parts[2]
+	items, err := findCustomVocabularyItemsByThreePartKey(ctx, conn, localeID, botID, botVersion)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lex v2 Custom Vocabulary (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	data.BotID = fwflex.StringValueToFramework(ctx, botID)
+	data.BotVersion = fwflex.StringValueToFramework(ctx, botVersion)
+	data.LocaleID = fwflex.StringValueToFramework(ctx, localeID)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, items, &data.CustomVocabularyItems)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customVocabularyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old customVocabularyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ID)
+	parts, err := intflex.ExpandResourceId(id, customVocabularyResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	localeID, botID, botVersion := parts[0], parts[1], parts[2]
+
+	if !new.CustomVocabularyItems.Equal(old.CustomVocabularyItems) {
+		var items []awstypes.NewCustomVocabularyItem
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.CustomVocabularyItems, &items)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Item IDs are assigned by the service, so match planned items to existing ones by phrase.
+		existing, err := findCustomVocabularyItemsByThreePartKey(ctx, conn, localeID, botID, botVersion)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Lex v2 Custom Vocabulary (%s)", id), err.Error())
+
+			return
+		}
+
+		existingByPhrase := make(map[string]awstypes.CustomVocabularyItem, len(existing))
+		for _, v := range existing {
+			existingByPhrase[aws.ToString(v.Phrase)] = v
+		}
+
+		var add []awstypes.NewCustomVocabularyItem
+		var update []awstypes.CustomVocabularyItem
+		for _, v := range items {
+			phrase := aws.ToString(v.Phrase)
+			e, ok := existingByPhrase[phrase]
+			if !ok {
+				add = append(add, v)
+				continue
+			}
+
+			delete(existingByPhrase, phrase)
+
+			if aws.ToString(e.DisplayAs) != aws.ToString(v.DisplayAs) || aws.ToInt32(e.Weight) != aws.ToInt32(v.Weight) {
+				update = append(update, awstypes.CustomVocabularyItem{
+					DisplayAs: v.DisplayAs,
+					ItemId:    e.ItemId,
+					Phrase:    v.Phrase,
+					Weight:    v.Weight,
+				})
+			}
+		}
+
+		var del []awstypes.CustomVocabularyEntryId
+		for _, v := range existingByPhrase {
+			del = append(del, awstypes.CustomVocabularyEntryId{
+				ItemId: v.ItemId,
+			})
+		}
+
+		if len(del) > 0 {
+			input := lexmodelsv2.BatchDeleteCustomVocabularyItemInput{
+				BotId:                    aws.String(botID),
+				BotVersion:               aws.String(botVersion),
+				CustomVocabularyItemList: del,
+				LocaleId:                 aws.String(localeID),
+			}
+			output, err := conn.BatchDeleteCustomVocabularyItem(ctx, &input)
+
+			if err == nil {
+				err = failedCustomVocabularyItemsError(output.Errors)
+			}
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("deleting Lex v2 Custom Vocabulary (%s) items", id), err.Error())
+
+				return
+			}
+		}
+
+		if len(update) > 0 {
+			input := lexmodelsv2.BatchUpdateCustomVocabularyItemInput{
+				BotId:                    aws.String(botID),
+				BotVersion:               aws.String(botVersion),
+				CustomVocabularyItemList: update,
+				LocaleId:                 aws.String(localeID),
+			}
+			output, err := conn.BatchUpdateCustomVocabularyItem(ctx, &input)
+
+			if err == nil {
+				err = failedCustomVocabularyItemsError(output.Errors)
+			}
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Lex v2 Custom Vocabulary (%s) items", id), err.Error())
+
+				return
+			}
+		}
+
+		if len(add) > 0 {
+			input := lexmodelsv2.BatchCreateCustomVocabularyItemInput{
+				BotId:                    aws.String(botID),
+				BotVersion:               aws.String(botVersion),
+				CustomVocabularyItemList: add,
+				LocaleId:                 aws.String(localeID),
+			}
+			output, err := conn.BatchCreateCustomVocabularyItem(ctx, &input)
+
+			if err == nil {
+				err = failedCustomVocabularyItemsError(output.Errors)
+			}
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("creating Lex v2 Custom Vocabulary (%s) items", id), err.Error())
+
+				return
+			}
+		}
+
+		if _, err := waitCustomVocabularyReady(ctx, conn, localeID, botID, botVersion, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Custom Vocabulary (%s) update", id), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *customVocabularyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customVocabularyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	parts, err := intflex.ExpandResourceId(id, customVocabularyResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	localeID, botID, botVersion := parts[0], parts[1], parts[2]
+	input := lexmodelsv2.DeleteCustomVocabularyInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+	_, err = conn.DeleteCustomVocabulary(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) ||
+		errs.IsAErrorMessageContains[*awstypes.PreconditionFailedException](err, "does not exist") {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lex v2 Custom Vocabulary (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitCustomVocabularyDeleted(ctx, conn, localeID, botID, botVersion, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lex v2 Custom Vocabulary (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func findCustomVocabularyMetadataByThreePartKey(ctx context.Context, conn *lexmodelsv2.Client, localeID, botID, botVersion string) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	input := lexmodelsv2.DescribeCustomVocabularyMetadataInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+	output, err := conn.DescribeCustomVocabularyMetadata(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findCustomVocabularyItemsByThreePartKey(ctx context.Context, conn *lexmodelsv2.Client, localeID, botID, botVersion string) ([]awstypes.CustomVocabularyItem, error) {
+	input := lexmodelsv2.ListCustomVocabularyItemsInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+	var output []awstypes.CustomVocabularyItem
+
+	pages := lexmodelsv2.NewListCustomVocabularyItemsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CustomVocabularyItems...)
+	}
+
+	return output, nil
+}
+
+func statusCustomVocabulary(ctx context.Context, conn *lexmodelsv2.Client, localeID, botID, botVersion string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findCustomVocabularyMetadataByThreePartKey(ctx, conn, localeID, botID, botVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CustomVocabularyStatus), nil
+	}
+}
+
+func waitCustomVocabularyReady(ctx context.Context, conn *lexmodelsv2.Client, localeID, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.CustomVocabularyStatusCreating, awstypes.CustomVocabularyStatusImporting),
+		Target:                    enum.Slice(awstypes.CustomVocabularyStatusReady),
+		Refresh:                   statusCustomVocabulary(ctx, conn, localeID, botID, botVersion),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeCustomVocabularyMetadataOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomVocabularyDeleted(ctx context.Context, conn *lexmodelsv2.Client, localeID, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CustomVocabularyStatusDeleting, awstypes.CustomVocabularyStatusReady),
+		Target:  []string{},
+		Refresh: statusCustomVocabulary(ctx, conn, localeID, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeCustomVocabularyMetadataOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func failedCustomVocabularyItemsError(apiObjects []awstypes.FailedCustomVocabularyItem) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(apiObject.ItemId), apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+type customVocabularyResourceModel struct {
+	framework.WithRegionModel
+	BotID                 types.String                                              `tfsdk:"bot_id"`
+	BotVersion            types.String                                              `tfsdk:"bot_version"`
+	CustomVocabularyItems fwtypes.SetNestedObjectValueOf[customVocabularyItemModel] `tfsdk:"custom_vocabulary_item"`
+	ID                    types.String                                              `tfsdk:"id"`
+	LocaleID              types.String                                              `tfsdk:"locale_id"`
+	Timeouts              timeouts.Value                                            `tfsdk:"timeouts"`
+}
+
+type customVocabularyItemModel struct {
+	DisplayAs types.String `tfsdk:"display_as"`
+	Phrase    types.String `tfsdk:"phrase"`
+	Weight    types.Int64  `tfsdk:"weight"`
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsCustomVocabulary_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var items []types.CustomVocabularyItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+	botLocaleResourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &items),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botLocaleResourceName, "bot_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "custom_vocabulary_item.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_vocabulary_item.*", map[string]string{
+						"phrase":         "terraform",
+						names.AttrWeight: "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_vocabulary_item.*", map[string]string{
+						"phrase":         "hashicorp",
+						"display_as":     "HashiCorp",
+						names.AttrWeight: "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomVocabularyConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &items),
+					resource.TestCheckResourceAttr(resourceName, "custom_vocabulary_item.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_vocabulary_item.*", map[string]string{
+						"phrase":         "terraform",
+						names.AttrWeight: "3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_vocabulary_item.*", map[string]string{
+						"phrase":         "opentofu",
+						names.AttrWeight: "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsCustomVocabulary_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var items []types.CustomVocabularyItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &items),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceCustomVocabulary, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomVocabularyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_custom_vocabulary" {
+				continue
+			}
+
+			_, err := tflexv2models.FindCustomVocabularyItemsByThreePartKey(ctx, conn, rs.Primary.Attributes["locale_id"], rs.Primary.Attributes["bot_id"], rs.Primary.Attributes["bot_version"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lex v2 Custom Vocabulary %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomVocabularyExists(ctx context.Context, n string, v *[]types.CustomVocabularyItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		output, err := tflexv2models.FindCustomVocabularyItemsByThreePartKey(ctx, conn, rs.Primary.Attributes["locale_id"], rs.Primary.Attributes["bot_id"], rs.Primary.Attributes["bot_version"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCustomVocabularyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+		`
+resource "aws_lexv2models_custom_vocabulary" "test" {
+  bot_id      = aws_lexv2models_bot_locale.test.bot_id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  custom_vocabulary_item {
+    phrase = "terraform"
+    weight = 2
+  }
+
+  custom_vocabulary_item {
+    phrase     = "hashicorp"
+    display_as = "HashiCorp"
+    weight     = 1
+  }
+}
+`)
+}
+
+func testAccCustomVocabularyConfig_updated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+		`
+resource "aws_lexv2models_custom_vocabulary" "test" {
+  bot_id      = aws_lexv2models_bot_locale.test.bot_id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  custom_vocabulary_item {
+    phrase = "terraform"
+    weight = 3
+  }
+
+  custom_vocabulary_item {
+    phrase = "opentofu"
+    weight = 1
+  }
+}
+`)
+}
@@ -5,17 +5,19 @@ package lexv2models
 
 // Exports for use in tests only.
 var (
-	ResourceBot        = newBotResource
-	ResourceBotLocale  = newBotLocaleResource
-	ResourceBotVersion = newBotVersionResource
-	ResourceIntent     = newIntentResource
-	ResourceSlot       = newSlotResource
-	ResourceSlotType   = newSlotTypeResource
+	ResourceBot              = newBotResource
+	ResourceBotLocale        = newBotLocaleResource
+	ResourceBotVersion       = newBotVersionResource
+	ResourceCustomVocabulary = newCustomVocabularyResource
+	ResourceIntent           = newIntentResource
+	ResourceSlot             = newSlotResource
+	ResourceSlotType         = newSlotTypeResource
 
-	FindBotByID                 = findBotByID
-	FindBotLocaleByThreePartKey = findBotLocaleByThreePartKey
-	FindBotVersionByTwoPartKey  = findBotVersionByTwoPartKey
-	FindSlotByID                = findSlotByID
+	FindBotByID                             = findBotByID
+	FindBotLocaleByThreePartKey             = findBotLocaleByThreePartKey
+	FindBotVersionByTwoPartKey              = findBotVersionByTwoPartKey
+	FindCustomVocabularyItemsByThreePartKey = findCustomVocabularyItemsByThreePartKey
+	FindSlotByID                            = findSlotByID
 
 	IntentFlexOpt = intentFlexOpt
 
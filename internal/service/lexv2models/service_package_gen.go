@@ -44,6 +44,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Bot Version",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newCustomVocabularyResource,
+			TypeName: "aws_lexv2models_custom_vocabulary",
+			Name:     "Custom Vocabulary",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newIntentResource,
 			TypeName: "aws_lexv2models_intent",
@@ -5,6 +5,10 @@ package mediapackagev2
 
 // Exports for use in tests only.
 var (
-	FindChannelGroupByID = findChannelGroupByID
-	ResourceChannelGroup = newChannelGroupResource
+	FindChannelByTwoPartKey          = findChannelByTwoPartKey
+	FindChannelGroupByID             = findChannelGroupByID
+	FindOriginEndpointByThreePartKey = findOriginEndpointByThreePartKey
+	ResourceChannel                  = newChannelResource
+	ResourceChannelGroup             = newChannelGroupResource
+	ResourceOriginEndpoint           = newOriginEndpointResource
 )
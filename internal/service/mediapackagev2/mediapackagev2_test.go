@@ -15,12 +15,22 @@ func TestAccMediaPackageV2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"Channel": {
+			acctest.CtBasic:      testAccMediaPackageV2Channel_basic,
+			"description":        testAccMediaPackageV2Channel_description,
+			acctest.CtDisappears: testAccMediaPackageV2Channel_disappears,
+		},
 		"ChannelGroup": {
 			acctest.CtBasic:      testAccMediaPackageV2ChannelGroup_basic,
 			"description":        testAccMediaPackageV2ChannelGroup_description,
 			acctest.CtDisappears: testAccMediaPackageV2ChannelGroup_disappears,
 			"tags":               testAccMediaPackageV2ChannelGroup_tagsSerial,
 		},
+		"OriginEndpoint": {
+			acctest.CtBasic:      testAccMediaPackageV2OriginEndpoint_basic,
+			acctest.CtDisappears: testAccMediaPackageV2OriginEndpoint_disappears,
+			"policy":             testAccMediaPackageV2OriginEndpoint_policy,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameOriginEndpoint         = "Origin Endpoint"
+	OriginEndpointFieldNamePrefix = "OriginEndpoint"
+
+	originEndpointIDPartCount = 3
+)
+
+// @FrameworkResource("aws_media_packagev2_origin_endpoint", name="Origin Endpoint")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mediapackagev2;mediapackagev2.GetOriginEndpointOutput")
+// @Testing(serialize=true)
+// @Testing(tagsTest=false)
+func newOriginEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &originEndpointResource{}
+
+	return r, nil
+}
+
+type originEndpointResource struct {
+	framework.ResourceWithModel[originEndpointResourceModel]
+}
+
+func (r *originEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"channel_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"container_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"segment": schema.ListAttribute{ // proto5 Optional+Computed nested block.
+				CustomType: fwtypes.NewListNestedObjectTypeOf[segmentModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[segmentModel](ctx),
+				},
+			},
+			"startover_window_seconds": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"hls_manifest": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[hlsManifestModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"child_manifest_name": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"manifest_name": schema.StringAttribute{
+							Required: true,
+						},
+						"manifest_window_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"program_date_time_interval_seconds": schema.Int32Attribute{
+							Optional: true,
+						},
+						names.AttrURL: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *originEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediapackagev2.CreateOriginEndpointInput{
+		Tags: getTagsIn(ctx),
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateOriginEndpoint(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionCreating, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Policy.IsNull() {
+		if err := putOriginEndpointPolicy(ctx, conn, data); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionCreating, ResNameOriginEndpoint, data.Name.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	channelGroupName, channelName, name := data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.Name.ValueString()
+	output, err := findOriginEndpointByThreePartKey(ctx, conn, channelGroupName, channelName, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionReading, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := findOriginEndpointPolicyByThreePartKey(ctx, conn, channelGroupName, channelName, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		data.Policy = fwtypes.IAMPolicyNull()
+	case err != nil:
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionReading, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	default:
+		data.Policy = fwtypes.IAMPolicyValue(aws.ToString(policy.Policy))
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var state, plan originEndpointResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := fwflex.Diff(ctx, plan, state, fwflex.WithIgnoredField("Policy"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := mediapackagev2.UpdateOriginEndpointInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateOriginEndpoint(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionUpdating, ResNameOriginEndpoint, state.Name.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &plan, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Policy.Equal(state.Policy) {
+		var err error
+
+		if plan.Policy.IsNull() {
+			err = deleteOriginEndpointPolicy(ctx, conn, plan)
+		} else {
+			err = putOriginEndpointPolicy(ctx, conn, plan)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionUpdating, ResNameOriginEndpoint, state.Name.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *originEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Origin Endpoint", map[string]any{
+		"channel_group_name": data.ChannelGroupName.ValueString(),
+		"channel_name":       data.ChannelName.ValueString(),
+		names.AttrName:       data.Name.ValueString(),
+	})
+
+	input := mediapackagev2.DeleteOriginEndpointInput{
+		ChannelGroupName:   data.ChannelGroupName.ValueStringPointer(),
+		ChannelName:        data.ChannelName.ValueStringPointer(),
+		OriginEndpointName: data.Name.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteOriginEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionDeleting, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, 5*time.Minute, func(ctx context.Context) (any, error) {
+		return findOriginEndpointByThreePartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.Name.ValueString())
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionWaitingForDeletion, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *originEndpointResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, originEndpointIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionImporting, ResNameOriginEndpoint, request.ID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("channel_group_name"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("channel_name"), parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrName), parts[2])...)
+}
+
+type originEndpointResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                      `tfsdk:"arn"`
+	ChannelGroupName       types.String                                      `tfsdk:"channel_group_name"`
+	ChannelName            types.String                                      `tfsdk:"channel_name"`
+	ContainerType          fwtypes.StringEnum[awstypes.ContainerType]        `tfsdk:"container_type"`
+	Description            types.String                                      `tfsdk:"description"`
+	HLSManifests           fwtypes.ListNestedObjectValueOf[hlsManifestModel] `tfsdk:"hls_manifest"`
+	Name                   types.String                                      `tfsdk:"name"`
+	Policy                 fwtypes.IAMPolicy                                 `tfsdk:"policy"`
+	Segment                fwtypes.ListNestedObjectValueOf[segmentModel]     `tfsdk:"segment"`
+	StartoverWindowSeconds types.Int32                                       `tfsdk:"startover_window_seconds"`
+	Tags                   tftags.Map                                        `tfsdk:"tags"`
+	TagsAll                tftags.Map                                        `tfsdk:"tags_all"`
+}
+
+type hlsManifestModel struct {
+	ChildManifestName              types.String `tfsdk:"child_manifest_name"`
+	ManifestName                   types.String `tfsdk:"manifest_name"`
+	ManifestWindowSeconds          types.Int32  `tfsdk:"manifest_window_seconds"`
+	ProgramDateTimeIntervalSeconds types.Int32  `tfsdk:"program_date_time_interval_seconds"`
+	URL                            types.String `tfsdk:"url"`
+}
+
+type segmentModel struct {
+	IncludeIframeOnlyStreams types.Bool   `tfsdk:"include_iframe_only_streams"`
+	SegmentDurationSeconds   types.Int32  `tfsdk:"segment_duration_seconds"`
+	SegmentName              types.String `tfsdk:"segment_name"`
+	TsIncludeDvbSubtitles    types.Bool   `tfsdk:"ts_include_dvb_subtitles"`
+	TsUseAudioRenditionGroup types.Bool   `tfsdk:"ts_use_audio_rendition_group"`
+}
+
+func putOriginEndpointPolicy(ctx context.Context, conn *mediapackagev2.Client, data originEndpointResourceModel) error {
+	input := mediapackagev2.PutOriginEndpointPolicyInput{
+		ChannelGroupName:   data.ChannelGroupName.ValueStringPointer(),
+		ChannelName:        data.ChannelName.ValueStringPointer(),
+		OriginEndpointName: data.Name.ValueStringPointer(),
+		Policy:             data.Policy.ValueStringPointer(),
+	}
+
+	_, err := conn.PutOriginEndpointPolicy(ctx, &input)
+
+	return err
+}
+
+func deleteOriginEndpointPolicy(ctx context.Context, conn *mediapackagev2.Client, data originEndpointResourceModel) error {
+	input := mediapackagev2.DeleteOriginEndpointPolicyInput{
+		ChannelGroupName:   data.ChannelGroupName.ValueStringPointer(),
+		ChannelName:        data.ChannelName.ValueStringPointer(),
+		OriginEndpointName: data.Name.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteOriginEndpointPolicy(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findOriginEndpointByThreePartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointOutput, error) {
+	in := &mediapackagev2.GetOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	out, err := conn.GetOriginEndpoint(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastRequest: in,
+			LastError:   err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findOriginEndpointPolicyByThreePartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointPolicyOutput, error) {
+	in := &mediapackagev2.GetOriginEndpointPolicyInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	out, err := conn.GetOriginEndpointPolicy(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastRequest: in,
+			LastError:   err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+	channelResourceName := "aws_media_packagev2_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediapackagev2", regexache.MustCompile(`channelGroup/.+/channel/.+/originEndpoint/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", channelResourceName, "channel_group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", channelResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "TS"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "segment.0.segment_duration_seconds"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    testAccOriginEndpointImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_basic(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2OriginEndpoint_policy(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    testAccOriginEndpointImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrPolicy),
+				),
+			},
+		},
+	})
+}
+
+func testAccOriginEndpointImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName]), nil
+	}
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagev2_origin_endpoint" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaPackageV2, create.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameOriginEndpoint, rs.Primary.Attributes[names.AttrName], err)
+			}
+
+			return fmt.Errorf("MediaPackageV2 Origin Endpoint: %s not deleted", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, name string, originEndpoint *mediapackagev2.GetOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+		resp, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return create.Error(names.MediaPackageV2, create.ErrActionCheckingExistence, tfmediapackagev2.ResNameOriginEndpoint, rs.Primary.Attributes[names.AttrName], err)
+		}
+
+		*originEndpoint = *resp
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name = aws_media_packagev2_channel.test.channel_group_name
+  channel_name       = aws_media_packagev2_channel.test.name
+  name               = %[1]q
+  description        = %[2]q
+  container_type     = "TS"
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+}
+`, rName, description))
+}
+
+func testAccOriginEndpointConfig_policy(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name = aws_media_packagev2_channel.test.channel_group_name
+  channel_name       = aws_media_packagev2_channel.test.name
+  name               = %[1]q
+  description        = "description1"
+  container_type     = "TS"
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowAccount"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "mediapackagev2:GetObject"
+      Resource = "arn:${data.aws_partition.current.partition}:mediapackagev2:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:channelGroup/${aws_media_packagev2_channel.test.channel_group_name}/channel/${aws_media_packagev2_channel.test.name}/originEndpoint/%[1]s"
+    }]
+  })
+}
+`, rName))
+}
@@ -272,10 +272,20 @@ func resourceConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	d.Set(names.AttrARN, connectPeerARN(ctx, meta.(*conns.AWSClient), d.Id()))
-	d.Set("bgp_options", []any{map[string]any{
-		"peer_asn": connectPeer.Configuration.BgpConfigurations[0].PeerAsn,
-	}})
-	d.Set(names.AttrConfiguration, []any{flattenPeerConfiguration(connectPeer.Configuration)})
+	if configuration := connectPeer.Configuration; configuration != nil && len(configuration.BgpConfigurations) > 0 {
+		d.Set("bgp_options", []any{map[string]any{
+			"peer_asn": aws.ToInt64(configuration.BgpConfigurations[0].PeerAsn),
+		}})
+	} else {
+		d.Set("bgp_options", nil)
+	}
+	if connectPeer.Configuration != nil {
+		if err := d.Set(names.AttrConfiguration, []any{flattenPeerConfiguration(connectPeer.Configuration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+		}
+	} else {
+		d.Set(names.AttrConfiguration, nil)
+	}
 	d.Set("connect_peer_id", connectPeer.ConnectPeerId)
 	d.Set("core_network_id", connectPeer.CoreNetworkId)
 	if connectPeer.CreatedAt != nil {
@@ -285,8 +295,10 @@ func resourceConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta a
 	}
 	d.Set("edge_location", connectPeer.EdgeLocation)
 	d.Set("connect_attachment_id", connectPeer.ConnectAttachmentId)
-	d.Set("inside_cidr_blocks", connectPeer.Configuration.InsideCidrBlocks)
-	d.Set("peer_address", connectPeer.Configuration.PeerAddress)
+	if connectPeer.Configuration != nil {
+		d.Set("inside_cidr_blocks", connectPeer.Configuration.InsideCidrBlocks)
+		d.Set("peer_address", connectPeer.Configuration.PeerAddress)
+	}
 	d.Set("subnet_arn", connectPeer.SubnetArn)
 	d.Set(names.AttrState, connectPeer.State)
 
@@ -424,10 +436,12 @@ func statusConnectPeerState(ctx context.Context, conn *networkmanager.Client, id
 
 func waitConnectPeerCreated(ctx context.Context, conn *networkmanager.Client, id string, timeout time.Duration) (*awstypes.ConnectPeer, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.ConnectPeerStateCreating),
-		Target:  enum.Slice(awstypes.ConnectPeerStateAvailable),
-		Timeout: timeout,
-		Refresh: statusConnectPeerState(ctx, conn, id),
+		Pending:      enum.Slice(awstypes.ConnectPeerStateCreating),
+		Target:       enum.Slice(awstypes.ConnectPeerStateAvailable),
+		Timeout:      timeout,
+		Refresh:      statusConnectPeerState(ctx, conn, id),
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
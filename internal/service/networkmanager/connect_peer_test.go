@@ -47,6 +47,12 @@ func TestAccNetworkManagerConnectPeer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.peer_address", peerAddress),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.protocol", "GRE"),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.bgp_configurations.0.core_network_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.bgp_configurations.0.core_network_asn"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.bgp_configurations.0.peer_address"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.0.peer_asn", asn),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.1.peer_asn", asn),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_asn", asn),
 					resource.TestCheckResourceAttrSet(resourceName, "connect_attachment_id"),
 					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.0", insideCidrBlocksv4),
 					resource.TestCheckResourceAttr(resourceName, "peer_address", peerAddress),
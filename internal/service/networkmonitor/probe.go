@@ -141,7 +141,7 @@ func (r *probeResource) Create(ctx context.Context, request resource.CreateReque
 	outputCP, err := conn.CreateProbe(ctx, input)
 
 	if err != nil {
-		response.Diagnostics.AddError("creating CloudWatch Network Monitor Probe (%s)", err.Error())
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Network Monitor Probe (%s)", data.MonitorName.ValueString()), err.Error())
 
 		return
 	}
@@ -151,7 +151,7 @@ func (r *probeResource) Create(ctx context.Context, request resource.CreateReque
 	data.ProbeID = fwflex.StringToFramework(ctx, outputCP.ProbeId)
 	id, err := data.setID()
 	if err != nil {
-		response.Diagnostics.AddError("creating CloudWatch Network Monitor Probe (%s)", err.Error())
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Network Monitor Probe (%s)", data.MonitorName.ValueString()), err.Error())
 		return
 	}
 	data.ID = types.StringValue(id)
@@ -199,7 +199,7 @@ func (r *probeResource) Read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Network Monitor Probe (%s)", data.ID.String()), err.Error())
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Network Monitor Probe (%s)", data.ID.ValueString()), err.Error())
 
 		return
 	}
@@ -253,7 +253,7 @@ func (r *probeResource) Update(ctx context.Context, request resource.UpdateReque
 		_, err := conn.UpdateProbe(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Network Monitor Probe (%s)", new.ID.String()), err.Error())
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Network Monitor Probe (%s)", new.ID.ValueString()), err.Error())
 
 			return
 		}
@@ -268,6 +268,7 @@ func (r *probeResource) Update(ctx context.Context, request resource.UpdateReque
 
 		// Set values for unknowns.
 		new.AddressFamily = fwtypes.StringEnumValue(outputGP.AddressFamily)
+		new.VpcID = fwflex.StringToFramework(ctx, outputGP.VpcId)
 	} else {
 		new.AddressFamily = old.AddressFamily
 	}
@@ -74,16 +74,19 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"Type_Backup":            testAccPolicy_type_Backup,
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
+			"Type_RCP":               testAccPolicy_type_RCP,
+			"Type_Declarative":       testAccPolicy_type_Declarative,
 			"ImportAwsManagedPolicy": testAccPolicy_importManagedPolicy,
 			"Identity":               testAccOrganizationsPolicy_IdentitySerial,
 		},
 		"PolicyAttachment": {
-			"Account":            testAccPolicyAttachment_Account,
-			"OrganizationalUnit": testAccPolicyAttachment_OrganizationalUnit,
-			"Root":               testAccPolicyAttachment_Root,
-			"SkipDestroy":        testAccPolicyAttachment_skipDestroy,
-			acctest.CtDisappears: testAccPolicyAttachment_disappears,
-			"Identity":           testAccOrganizationsPolicyAttachment_IdentitySerial,
+			"Account":               testAccPolicyAttachment_Account,
+			"OrganizationalUnit":    testAccPolicyAttachment_OrganizationalUnit,
+			"OrganizationalUnitRCP": testAccPolicyAttachment_OrganizationalUnitRCP,
+			"Root":                  testAccPolicyAttachment_Root,
+			"SkipDestroy":           testAccPolicyAttachment_skipDestroy,
+			acctest.CtDisappears:    testAccPolicyAttachment_disappears,
+			"Identity":              testAccOrganizationsPolicyAttachment_IdentitySerial,
 		},
 		"PolicyDataSource": {
 			"UnattachedPolicy": testAccPolicyDataSource_UnattachedPolicy,
@@ -5,6 +5,7 @@ package organizations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -79,7 +80,26 @@ func resourcePolicy() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
 			},
 		},
+
+		CustomizeDiff: validatePolicyContent,
+	}
+}
+
+// validatePolicyContent checks that authorization policies (SCPs and RCPs) are IAM policy documents.
+// Management policies (e.g. backup, tag and declarative policies) use their own JSON syntax.
+func validatePolicyContent(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown(names.AttrContent) || !d.NewValueKnown(names.AttrType) {
+		return nil
 	}
+
+	switch policyType := awstypes.PolicyType(d.Get(names.AttrType).(string)); policyType {
+	case awstypes.PolicyTypeServiceControlPolicy, awstypes.PolicyTypeResourceControlPolicy:
+		if _, errs := verify.ValidIAMPolicyJSON(d.Get(names.AttrContent).(string), names.AttrContent); len(errs) > 0 {
+			return fmt.Errorf("%s content: %w", policyType, errors.Join(errs...))
+		}
+	}
+
+	return nil
 }
 
 func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
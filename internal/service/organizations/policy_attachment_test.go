@@ -94,6 +94,38 @@ func testAccPolicyAttachment_OrganizationalUnit(t *testing.T) {
 	})
 }
 
+func testAccPolicyAttachment_OrganizationalUnitRCP(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy_attachment.test"
+	policyIdResourceName := "aws_organizations_policy.test"
+	targetIdResourceName := "aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentConfig_organizationalUnitRCP(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policyIdResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", targetIdResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(policyIdResourceName, names.AttrType, string(awstypes.PolicyTypeResourceControlPolicy)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy},
+			},
+		},
+	})
+}
+
 func testAccPolicyAttachment_Root(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -301,6 +333,46 @@ resource "aws_organizations_policy_attachment" "test" {
 `, rName)
 }
 
+func testAccPolicyAttachmentConfig_organizationalUnitRCP(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["RESOURCE_CONTROL_POLICY"]
+}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  content = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Deny"
+      Principal = "*"
+      Action    = "s3:*"
+      Resource  = "*"
+      Condition = {
+        BoolIfExists = {
+          "aws:SecureTransport" = "false"
+        }
+      }
+    }]
+  })
+
+  name = %[1]q
+  type = "RESOURCE_CONTROL_POLICY"
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organizational_unit.test.id
+}
+`, rName)
+}
+
 func testAccPolicyAttachmentConfig_root(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {
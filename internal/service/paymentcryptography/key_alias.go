@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -53,9 +54,9 @@ func (r *keyAliasResource) Schema(ctx context.Context, request resource.SchemaRe
 			},
 			"key_arn": schema.StringAttribute{
 				Optional: true,
-				// Validators: []validator.String{
-				// 	stringvalidator.RegexMatches(regexache.MustCompile(`^arn:aws:payment-cryptography:[a-z]{2}-[a-z]{1,16}-[0-9]+:[0-9]{12}:key/[0-9a-zA-Z]{16,64}$`), "valid arn is required. Minimum length of 70. Maximum length of 150"),
-				// },
+				Validators: []validator.String{
+					fwvalidators.ARN(),
+				},
 			},
 			names.AttrID: framework.IDAttribute(),
 		},
@@ -160,7 +161,7 @@ func (r *keyAliasResource) Update(ctx context.Context, request resource.UpdateRe
 		_, err := conn.UpdateAlias(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading PaymentCryptography key Alias (%s)", new.ID.String()), err.Error())
+			response.Diagnostics.AddError(fmt.Sprintf("updating PaymentCryptography Key Alias (%s)", new.ID.String()), err.Error())
 			return
 		}
 	}
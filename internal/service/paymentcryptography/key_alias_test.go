@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -28,7 +29,7 @@ func TestAccPaymentCryptographyKeyAlias_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			//acctest.PreCheckPartitionHasService(t, names.PaymentCryptographyEndpointID) // Causing a false negative
+			testAccPreCheck(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -60,7 +61,7 @@ func TestAccPaymentCryptographyKeyAlias_disappears(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			//acctest.PreCheckPartitionHasService(t, names.PaymentCryptographyEndpointID) // Causing a false negative
+			testAccPreCheck(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -87,7 +88,7 @@ func TestAccPaymentCryptographyKeyAlias_update(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			//acctest.PreCheckPartitionHasService(t, names.PaymentCryptographyEndpointID) // Causing a false negative
+			testAccPreCheck(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -128,7 +129,7 @@ func TestAccPaymentCryptographyKeyAlias_updateName(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			//acctest.PreCheckPartitionHasService(t, names.PaymentCryptographyEndpointID) // Causing a false negative
+			testAccPreCheck(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -159,6 +160,27 @@ func TestAccPaymentCryptographyKeyAlias_updateName(t *testing.T) {
 	})
 }
 
+func TestAccPaymentCryptographyKeyAlias_invalidKeyARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("alias/")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyAliasConfig_keyARN(rName, "not-an-arn"),
+				ExpectError: regexache.MustCompile(`value must be a valid ARN`),
+			},
+		},
+	})
+}
+
 func testAccCheckKeyAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyClient(ctx)
@@ -276,3 +298,12 @@ resource "aws_paymentcryptography_key" "test" {
 }
 `, name)
 }
+
+func testAccKeyAliasConfig_keyARN(name, keyARN string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key_alias" "test" {
+  alias_name = %[1]q
+  key_arn    = %[2]q
+}
+`, name, keyARN)
+}
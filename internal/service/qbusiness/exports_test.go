@@ -5,6 +5,10 @@ package qbusiness
 
 var (
 	ResourceApplication = newApplicationResource
+	ResourceDataSource  = newDataSourceResource
+	ResourceIndex       = newIndexResource
 
-	FindApplicationByID = findApplicationByID
+	FindApplicationByID          = findApplicationByID
+	FindDataSourceByThreePartKey = findDataSourceByThreePartKey
+	FindIndexByTwoPartKey        = findIndexByTwoPartKey
 )
@@ -6,6 +6,7 @@ package qldb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -40,6 +41,8 @@ func resourceStream() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffStreamTimeWindow,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -226,6 +229,30 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return diags
 }
 
+func customizeDiffStreamTimeWindow(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	start, end := d.Get("inclusive_start_time").(string), d.Get("exclusive_end_time").(string)
+
+	if start == "" || end == "" {
+		return nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return err
+	}
+
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return err
+	}
+
+	if !endTime.After(startTime) {
+		return fmt.Errorf("exclusive_end_time (%s) must be after inclusive_start_time (%s)", end, start)
+	}
+
+	return nil
+}
+
 func findStreamByTwoPartKey(ctx context.Context, conn *qldb.Client, ledgerName, streamID string) (*types.JournalKinesisStreamDescription, error) {
 	input := &qldb.DescribeJournalKinesisStreamInput{
 		LedgerName: aws.String(ledgerName),
@@ -133,8 +133,8 @@ func TestAccQLDBStream_withEndTime(t *testing.T) {
 				Config: testAccStreamConfig_endTime(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckStreamExists(ctx, resourceName, &v),
-					resource.TestCheckResourceAttrSet(resourceName, "exclusive_end_time"),
-					resource.TestCheckResourceAttrSet(resourceName, "inclusive_start_time"),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_end_time", "2021-12-31T23:59:59Z"),
+					resource.TestCheckResourceAttr(resourceName, "inclusive_start_time", "2021-01-01T00:00:00Z"),
 					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "kinesis_configuration.0.aggregation_enabled", acctest.CtFalse),
 					resource.TestCheckResourceAttrSet(resourceName, "kinesis_configuration.0.stream_arn"),
@@ -144,6 +144,24 @@ func TestAccQLDBStream_withEndTime(t *testing.T) {
 	})
 }
 
+func TestAccQLDBStream_invalidTimeWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.QLDBEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QLDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStreamConfig_timeWindow(rName, "2021-06-01T00:00:00Z", "2021-01-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`exclusive_end_time .* must be after inclusive_start_time`),
+			},
+		},
+	})
+}
+
 func testAccCheckStreamExists(ctx context.Context, n string, v *types.JournalKinesisStreamDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -275,6 +293,23 @@ resource "aws_qldb_stream" "test" {
 `, rName))
 }
 
+func testAccStreamConfig_timeWindow(rName, start, end string) string {
+	return acctest.ConfigCompose(testAccStreamBaseConfig(rName), fmt.Sprintf(`
+resource "aws_qldb_stream" "test" {
+  stream_name          = %[1]q
+  ledger_name          = aws_qldb_ledger.test.id
+  exclusive_end_time   = %[3]q
+  inclusive_start_time = %[2]q
+  role_arn             = aws_iam_role.test.arn
+
+  kinesis_configuration {
+    aggregation_enabled = false
+    stream_arn          = aws_kinesis_stream.test.arn
+  }
+}
+`, rName, start, end))
+}
+
 func testAccStreamConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccStreamBaseConfig(rName), fmt.Sprintf(`
 resource "aws_qldb_stream" "test" {
@@ -5,6 +5,7 @@ package rbin
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -37,6 +38,8 @@ func resourceRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRuleCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -82,6 +85,10 @@ func resourceRule() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				MaxItems: 1,
+				// A rule that is pending unlock still reports its lock configuration until the unlock delay has passed.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return types.LockState(d.Get("lock_state").(string)) == types.LockStatePendingUnlock && len(d.Get("lock_configuration").([]any)) == 0
+				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"unlock_delay": {
@@ -187,6 +194,10 @@ func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.ExcludeResourceTags = expandResourceTags(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("lock_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.LockConfiguration = expandLockConfiguration(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk(names.AttrResourceTags); ok && v.(*schema.Set).Len() > 0 {
 		input.ResourceTags = expandResourceTags(v.(*schema.Set).List())
 	}
@@ -228,6 +239,19 @@ func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 	if err := d.Set("exclude_resource_tags", flattenResourceTags(output.ExcludeResourceTags)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting exclude_resource_tags: %s", err)
 	}
+	if output.LockConfiguration != nil {
+		if err := d.Set("lock_configuration", []any{flattenLockConfiguration(output.LockConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting lock_configuration: %s", err)
+		}
+	} else {
+		d.Set("lock_configuration", nil)
+	}
+	if output.LockEndTime != nil {
+		d.Set("lock_end_time", aws.ToTime(output.LockEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("lock_end_time", nil)
+	}
+	d.Set("lock_state", output.LockState)
 	if err := d.Set(names.AttrResourceTags, flattenResourceTags(output.ResourceTags)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting resource_tags: %s", err)
 	}
@@ -244,7 +268,7 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RBinClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "lock_configuration") {
 		input := rbin.UpdateRuleInput{
 			Identifier: aws.String(d.Id()),
 		}
@@ -286,9 +310,51 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
+	if d.HasChange("lock_configuration") {
+		if v, ok := d.GetOk("lock_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input := rbin.LockRuleInput{
+				Identifier:        aws.String(d.Id()),
+				LockConfiguration: expandLockConfiguration(v.([]any)[0].(map[string]any)),
+			}
+
+			_, err := conn.LockRule(ctx, &input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "locking RBin Rule (%s): %s", d.Id(), err)
+			}
+		} else if types.LockState(d.Get("lock_state").(string)) != types.LockStatePendingUnlock {
+			input := rbin.UnlockRuleInput{
+				Identifier: aws.String(d.Id()),
+			}
+
+			_, err := conn.UnlockRule(ctx, &input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "unlocking RBin Rule (%s): %s", d.Id(), err)
+			}
+		}
+
+		if _, err := waitRuleUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RBin Rule (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceRuleRead(ctx, d, meta)...)
 }
 
+func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || types.LockState(diff.Get("lock_state").(string)) != types.LockStateLocked {
+		return nil
+	}
+
+	// The lock configuration of a locked rule can't be modified in place. The rule must be unlocked first.
+	if o, n := diff.GetChange("lock_configuration"); len(o.([]any)) > 0 && len(n.([]any)) > 0 && diff.HasChange("lock_configuration") {
+		return fmt.Errorf("lock_configuration of locked RBin Rule (%s) cannot be changed; remove lock_configuration to unlock the rule, then configure the new lock_configuration", diff.Id())
+	}
+
+	return nil
+}
+
 func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RBinClient(ctx)
@@ -489,6 +555,60 @@ func expandResourceTags(tfList []any) []types.ResourceTag {
 	return apiObjects
 }
 
+func flattenLockConfiguration(apiObject *types.LockConfiguration) map[string]any {
+	tfMap := map[string]any{}
+
+	if v := apiObject.UnlockDelay; v != nil {
+		tfMap["unlock_delay"] = []any{flattenUnlockDelay(v)}
+	}
+
+	return tfMap
+}
+
+func flattenUnlockDelay(apiObject *types.UnlockDelay) map[string]any {
+	tfMap := map[string]any{
+		"unlock_delay_unit": apiObject.UnlockDelayUnit,
+	}
+
+	if v := apiObject.UnlockDelayValue; v != nil {
+		tfMap["unlock_delay_value"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func expandLockConfiguration(tfMap map[string]any) *types.LockConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LockConfiguration{}
+
+	if v, ok := tfMap["unlock_delay"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UnlockDelay = expandUnlockDelay(v[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func expandUnlockDelay(tfMap map[string]any) *types.UnlockDelay {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UnlockDelay{}
+
+	if v, ok := tfMap["unlock_delay_unit"].(string); ok && v != "" {
+		apiObject.UnlockDelayUnit = types.UnlockDelayUnit(v)
+	}
+
+	if v, ok := tfMap["unlock_delay_value"].(int); ok {
+		apiObject.UnlockDelayValue = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
 func expandRetentionPeriod(tfList []any) *types.RetentionPeriod {
 	if tfList == nil {
 		return nil
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/rbin"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -224,8 +226,35 @@ func TestAccRBinRule_lockConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.0.unlock_delay_unit", "DAYS"),
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.0.unlock_delay_value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "locked"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccRuleConfig_lockConfig(resourceType, "DAYS", "8"),
+				ExpectError: regexache.MustCompile(`lock_configuration of locked RBin Rule \(.+\) cannot be changed`),
+			},
+			{
+				Config: testAccRuleConfig_unlocked(resourceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, t, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "lock_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.0.unlock_delay_value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "pending_unlock"),
+				),
+			},
+			{
+				Config: testAccRuleConfig_unlocked(resourceType),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 		},
 	})
 }
@@ -432,6 +461,19 @@ resource "aws_rbin_rule" "test" {
 `, resourceType, delay_unit1, delay_value1)
 }
 
+func testAccRuleConfig_unlocked(resourceType string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  resource_type = %[1]q
+
+  retention_period {
+    retention_period_value = 10
+    retention_period_unit  = "DAYS"
+  }
+}
+`, resourceType)
+}
+
 func testAccRuleConfig_tags1(resourceType, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_rbin_rule" "test" {
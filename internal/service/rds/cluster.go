@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -690,6 +691,22 @@ func resourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				// Local write forwarding is only supported by Aurora MySQL and Aurora PostgreSQL provisioned clusters.
+				if !diff.Get("enable_local_write_forwarding").(bool) {
+					return nil
+				}
+
+				if engine := diff.Get(names.AttrEngine).(string); engine != "" && !slices.Contains([]string{ClusterEngineAuroraMySQL, ClusterEngineAuroraPostgreSQL}, engine) {
+					return fmt.Errorf(`"enable_local_write_forwarding" is not supported for engine %q`, engine)
+				}
+
+				if engineMode := diff.Get("engine_mode").(string); engineMode != "" && engineMode != engineModeProvisioned {
+					return fmt.Errorf(`"enable_local_write_forwarding" is not supported for engine_mode %q`, engineMode)
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -744,6 +761,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 			requiresModifyDbCluster = true
 		}
 
+		if v, ok := d.GetOk("enable_local_write_forwarding"); ok {
+			modifyDbClusterInput.EnableLocalWriteForwarding = aws.Bool(v.(bool))
+			requiresModifyDbCluster = true
+		}
+
 		if v, ok := d.GetOk(names.AttrDatabaseName); ok {
 			input.DatabaseName = aws.String(v.(string))
 		}
@@ -1023,6 +1045,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 			requiresModifyDbCluster = true
 		}
 
+		if v, ok := d.GetOk("enable_local_write_forwarding"); ok {
+			modifyDbClusterInput.EnableLocalWriteForwarding = aws.Bool(v.(bool))
+			requiresModifyDbCluster = true
+		}
+
 		if v, ok := d.GetOk("db_cluster_parameter_group_name"); ok {
 			input.DBClusterParameterGroupName = aws.String(v.(string))
 		}
@@ -1465,6 +1492,12 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.Set("enabled_cloudwatch_logs_exports", dbc.EnabledCloudwatchLogsExports)
 	d.Set("enable_http_endpoint", dbc.HttpEndpointEnabled)
+	switch dbc.LocalWriteForwardingStatus {
+	case types.LocalWriteForwardingStatusEnabled, types.LocalWriteForwardingStatusEnabling, types.LocalWriteForwardingStatusRequested:
+		d.Set("enable_local_write_forwarding", true)
+	default:
+		d.Set("enable_local_write_forwarding", false)
+	}
 	d.Set(names.AttrEndpoint, dbc.Endpoint)
 	d.Set(names.AttrEngine, dbc.Engine)
 	d.Set("engine_lifecycle_support", dbc.EngineLifecycleSupport)
@@ -50,7 +50,6 @@ func testAccClusterImportStep(n string) resource.TestStep {
 			"cluster_members",
 			"db_instance_parameter_group_name",
 			"enable_global_write_forwarding",
-			"enable_local_write_forwarding",
 			"manage_master_user_password",
 			"master_password",
 			"master_password_wo",
@@ -473,7 +472,6 @@ func TestAccRDSCluster_onlyMajorVersion(t *testing.T) {
 					"cluster_members",
 					"db_instance_parameter_group_name",
 					"enable_global_write_forwarding",
-					"enable_local_write_forwarding",
 					names.AttrEngineVersion,
 					"master_password",
 					"skip_final_snapshot",
@@ -3084,7 +3082,7 @@ func TestAccRDSCluster_localWriteForwarding(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterConfig_localWriteForwarding(rName),
+				Config: testAccClusterConfig_localWriteForwarding(rName, true),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
 					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "rds", fmt.Sprintf("cluster:%s", rName)),
@@ -3092,6 +3090,36 @@ func TestAccRDSCluster_localWriteForwarding(t *testing.T) {
 				),
 			},
 			testAccClusterImportStep(resourceName),
+			{
+				Config: testAccClusterConfig_localWriteForwarding(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "enable_local_write_forwarding", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_localWriteForwardingUnsupportedEngine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_localWriteForwardingUnsupportedEngine(rName),
+				ExpectError: regexache.MustCompile(`"enable_local_write_forwarding" is not supported for engine "mysql"`),
+			},
 		},
 	})
 }
@@ -3292,7 +3320,6 @@ func TestAccRDSCluster_GlobalClusterIdentifier_performanceInsightsEnabled(t *tes
 				ImportStateVerifyIgnore: []string{
 					"master_password",
 					"enable_global_write_forwarding",
-					"enable_local_write_forwarding",
 				},
 			},
 			{
@@ -6932,19 +6959,37 @@ resource "aws_rds_cluster" "test" {
 `, rName, tfrds.ClusterEngineAuroraMySQL, preferredBackupWindow)
 }
 
-func testAccClusterConfig_localWriteForwarding(rName string) string {
+func testAccClusterConfig_localWriteForwarding(rName string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
   cluster_identifier            = %[1]q
   database_name                 = "test"
   engine                        = "aurora-mysql"
   engine_version                = "8.0.mysql_aurora.3.04.0"
+  enable_local_write_forwarding = %[2]t
+  master_username               = "tfacctest"
+  master_password               = "avoid-plaintext-passwords"
+  apply_immediately             = true
+  skip_final_snapshot           = true
+}
+`, rName, enabled)
+}
+
+func testAccClusterConfig_localWriteForwardingUnsupportedEngine(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier            = %[1]q
+  engine                        = %[2]q
+  db_cluster_instance_class     = "db.m6gd.large"
+  storage_type                  = "io1"
+  allocated_storage             = 100
+  iops                          = 1000
   enable_local_write_forwarding = true
   master_username               = "tfacctest"
   master_password               = "avoid-plaintext-passwords"
   skip_final_snapshot           = true
 }
-`, rName)
+`, rName, tfrds.ClusterEngineMySQL)
 }
 
 func testAccClusterConfig_engineLifecycleSupport_disabled(rName string) string {
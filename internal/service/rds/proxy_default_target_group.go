@@ -116,6 +116,17 @@ func resourceProxyDefaultTargetGroupPut(ctx context.Context, d *schema.ResourceD
 
 	if v, ok := d.GetOk("connection_pool_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.ConnectionPoolConfig = expandConnectionPoolConfiguration(v.([]any)[0].(map[string]any))
+
+		// Explicitly send empty values so that removed settings are cleared in place.
+		if !d.IsNewResource() {
+			if d.HasChange("connection_pool_config.0.init_query") && input.ConnectionPoolConfig.InitQuery == nil {
+				input.ConnectionPoolConfig.InitQuery = aws.String("")
+			}
+
+			if d.HasChange("connection_pool_config.0.session_pinning_filters") && input.ConnectionPoolConfig.SessionPinningFilters == nil {
+				input.ConnectionPoolConfig.SessionPinningFilters = []string{}
+			}
+		}
 	}
 
 	_, err := conn.ModifyDBProxyTargetGroup(ctx, input)
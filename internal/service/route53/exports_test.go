@@ -37,9 +37,12 @@ var (
 	FindZoneAssociationByThreePartKey           = findZoneAssociationByThreePartKey
 	KeySigningKeyStatusActive                   = keySigningKeyStatusActive
 	KeySigningKeyStatusInactive                 = keySigningKeyStatusInactive
+	ServeSignatureDeleting                      = serveSignatureDeleting
 	ServeSignatureNotSigning                    = serveSignatureNotSigning
 	ServeSignatureSigning                       = serveSignatureSigning
 	WaitChangeInsync                            = waitChangeInsync
+	WaitHostedZoneDNSSECStatusUpdated           = waitHostedZoneDNSSECStatusUpdated
+	WaitKeySigningKeyStatusUpdated              = waitKeySigningKeyStatusUpdated
 )
 
 type Route53TrafficPolicyDoc = route53TrafficPolicyDoc
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -156,6 +157,14 @@ func resourceHealthCheck() *schema.Resource {
 				ForceNew:     true,
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
+				ConflictsWith: []string{
+					"fqdn",
+					names.AttrIPAddress,
+					names.AttrPort,
+					"regions",
+					"resource_path",
+					"search_string",
+				},
 			},
 			"search_string": {
 				Type:         schema.TypeString,
@@ -181,7 +190,10 @@ func resourceHealthCheck() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: triggersCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			triggersCustomizeDiff,
+			routingControlCustomizeDiff,
+		),
 	}
 }
 
@@ -482,6 +494,24 @@ func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any)
 	return nil
 }
 
+func routingControlCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.GetRawConfig().GetAttr(names.AttrType).IsKnown() {
+		return nil
+	}
+
+	// Use the raw config so that a routing control ARN that is not yet known still counts as configured.
+	routingControlARNConfigured := !d.GetRawConfig().GetAttr("routing_control_arn").IsNull()
+
+	switch healthCheckType := awstypes.HealthCheckType(strings.ToUpper(d.Get(names.AttrType).(string))); {
+	case healthCheckType == awstypes.HealthCheckTypeRecoveryControl && !routingControlARNConfigured:
+		return fmt.Errorf(`"routing_control_arn" is required when "type" is %q`, healthCheckType)
+	case healthCheckType != awstypes.HealthCheckTypeRecoveryControl && routingControlARNConfigured:
+		return fmt.Errorf(`"routing_control_arn" can only be set when "type" is %q`, awstypes.HealthCheckTypeRecoveryControl)
+	}
+
+	return nil
+}
+
 // See https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazonroute53.html#amazonroute53-resources-for-iam-policies.
 func healthCheckARN(ctx context.Context, c *conns.AWSClient, id string) string {
 	return c.GlobalARNNoAccount(ctx, "route53", "healthcheck/"+id)
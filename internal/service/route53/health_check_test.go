@@ -485,6 +485,7 @@ func TestAccRoute53HealthCheck_withRoutingControlARN(t *testing.T) {
 				Config: testAccHealthCheckConfig_routingControlARN(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", "aws_route53recoverycontrolconfig_routing_control.test", names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, names.AttrType, "RECOVERY_CONTROL"),
 				),
 			},
@@ -497,6 +498,32 @@ func TestAccRoute53HealthCheck_withRoutingControlARN(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_routingControlARNInvalidConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	routingControlARN := "arn:aws:route53-recovery-control::123456789012:controlpanel/abcdef/routingcontrol/abcdef" //lintignore:AWSAT003,AWSAT005
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_routingControlARNMissing(),
+				ExpectError: regexache.MustCompile(`"routing_control_arn" is required when "type" is "RECOVERY_CONTROL"`),
+			},
+			{
+				Config:      testAccHealthCheckConfig_routingControlARNWrongType(routingControlARN),
+				ExpectError: regexache.MustCompile(`"routing_control_arn" can only be set when "type" is "RECOVERY_CONTROL"`),
+			},
+			{
+				Config:      testAccHealthCheckConfig_routingControlARNWithEndpoint(routingControlARN),
+				ExpectError: regexache.MustCompile(`"routing_control_arn": conflicts with fqdn`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check awstypes.HealthCheck
@@ -803,6 +830,35 @@ resource "aws_route53_health_check" "test" {
 `, disabled)
 }
 
+func testAccHealthCheckConfig_routingControlARNMissing() string {
+	return `
+resource "aws_route53_health_check" "test" {
+  type = "RECOVERY_CONTROL"
+}
+`
+}
+
+func testAccHealthCheckConfig_routingControlARNWrongType(routingControlARN string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_health_check" "test" {
+  type                   = "CALCULATED"
+  child_health_threshold = 1
+  routing_control_arn    = %[1]q
+}
+`, routingControlARN)
+}
+
+func testAccHealthCheckConfig_routingControlARNWithEndpoint(routingControlARN string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_health_check" "test" {
+  fqdn                = "example.com"
+  port                = 80
+  type                = "RECOVERY_CONTROL"
+  routing_control_arn = %[1]q
+}
+`, routingControlARN)
+}
+
 func testAccHealthCheckConfig_routingControlARN(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_route53recoverycontrolconfig_cluster" "test" {
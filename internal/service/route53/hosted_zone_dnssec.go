@@ -89,7 +89,7 @@ func resourceHostedZoneDNSSECCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if _, err := waitHostedZoneDNSSECStatusUpdated(ctx, conn, d.Id(), signingStatus); err != nil {
+	if _, err := waitHostedZoneDNSSECStatusUpdated(ctx, conn, d.Id(), signingStatus, timeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Hosted Zone DNSSEC (%s) signing status update: %s", d.Id(), err)
 	}
 
@@ -136,7 +136,7 @@ func resourceHostedZoneDNSSECUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
-		if _, err := waitHostedZoneDNSSECStatusUpdated(ctx, conn, d.Id(), signingStatus); err != nil {
+		if _, err := waitHostedZoneDNSSECStatusUpdated(ctx, conn, d.Id(), signingStatus, timeout); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Hosted Zone DNSSEC (%s) signing status update: %s", d.Id(), err)
 		}
 	}
@@ -200,13 +200,19 @@ func hostedZoneDNSSECEnable(ctx context.Context, conn *route53.Client, hostedZon
 		HostedZoneId: aws.String(hostedZoneID),
 	}
 
-	output, err := conn.EnableHostedZoneDNSSEC(ctx, input)
+	// A newly created or activated key-signing key may not yet be visible to DNSSEC signing.
+	const (
+		timeout = 5 * time.Minute
+	)
+	outputRaw, err := tfresource.RetryWhenIsA[any, *awstypes.KeySigningKeyWithActiveStatusNotFound](ctx, timeout, func(ctx context.Context) (any, error) {
+		return conn.EnableHostedZoneDNSSEC(ctx, input)
+	})
 
 	if err != nil {
 		return fmt.Errorf("enabling Route 53 Hosted Zone DNSSEC (%s): %w", hostedZoneID, err)
 	}
 
-	if output.ChangeInfo != nil {
+	if output := outputRaw.(*route53.EnableHostedZoneDNSSECOutput); output.ChangeInfo != nil {
 		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), waitTimeout); err != nil {
 			return fmt.Errorf("waiting for Route 53 Hosted Zone DNSSEC (%s) synchronize: %w", hostedZoneID, err)
 		}
@@ -256,11 +262,9 @@ func statusHostedZoneDNSSEC(ctx context.Context, conn *route53.Client, hostedZon
 	}
 }
 
-func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Client, hostedZoneID, status string) (*awstypes.DNSSECStatus, error) { //nolint:unparam
-	const (
-		timeout = 5 * time.Minute
-	)
+func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Client, hostedZoneID, status string, timeout time.Duration) (*awstypes.DNSSECStatus, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
+		Pending:    hostedZoneDNSSECPendingStatuses(status),
 		Target:     []string{status},
 		Refresh:    statusHostedZoneDNSSEC(ctx, conn, hostedZoneID),
 		MinTimeout: 5 * time.Second,
@@ -279,3 +283,16 @@ func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Client
 
 	return nil, err
 }
+
+// hostedZoneDNSSECPendingStatuses returns the serve signature statuses that may be
+// observed while signing transitions to the specified status.
+func hostedZoneDNSSECPendingStatuses(status string) []string {
+	switch status {
+	case serveSignatureSigning:
+		return []string{serveSignatureNotSigning}
+	case serveSignatureNotSigning:
+		return []string{serveSignatureSigning, serveSignatureDeleting}
+	default:
+		return nil
+	}
+}
@@ -6,8 +6,18 @@ package route53_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -19,6 +29,123 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestHostedZoneDNSSECSigningLifecycle(t *testing.T) {
+	t.Parallel()
+
+	const keySigningKeyName = "test"
+
+	// Each step is served from its own hosted zone so that the steps can run in parallel.
+	// The stub returns the step's observed statuses in turn, repeating the last one.
+	steps := map[string]struct {
+		keySigningKey bool
+		target        string
+		observed      []string
+		expectedErr   string
+	}{
+		"activate key-signing key": {
+			keySigningKey: true,
+			target:        tfroute53.KeySigningKeyStatusActive,
+			observed:      []string{tfroute53.KeySigningKeyStatusInactive, tfroute53.KeySigningKeyStatusActive},
+		},
+		"deactivate key-signing key": {
+			keySigningKey: true,
+			target:        tfroute53.KeySigningKeyStatusInactive,
+			observed:      []string{tfroute53.KeySigningKeyStatusActive, tfroute53.KeySigningKeyStatusInactive},
+		},
+		"key-signing key internal failure": {
+			keySigningKey: true,
+			target:        tfroute53.KeySigningKeyStatusActive,
+			observed:      []string{tfroute53.KeySigningKeyStatusInactive, "INTERNAL_FAILURE"},
+			expectedErr:   "stub status message",
+		},
+		"enable signing": {
+			target:   tfroute53.ServeSignatureSigning,
+			observed: []string{tfroute53.ServeSignatureNotSigning, tfroute53.ServeSignatureSigning},
+		},
+		"disable signing": {
+			target:   tfroute53.ServeSignatureNotSigning,
+			observed: []string{tfroute53.ServeSignatureSigning, tfroute53.ServeSignatureDeleting, tfroute53.ServeSignatureNotSigning},
+		},
+	}
+
+	var (
+		mu       sync.Mutex
+		requests = make(map[string]int)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// GET /2013-04-01/hostedzone/{Id}/dnssec, where the hosted zone ID is the step name.
+		hostedZoneID, err := url.PathUnescape(strings.TrimSuffix(strings.TrimPrefix(r.URL.EscapedPath(), "/2013-04-01/hostedzone/"), "/dnssec"))
+		step, ok := steps[hostedZoneID]
+		if err != nil || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		status := step.observed[min(requests[hostedZoneID], len(step.observed)-1)]
+		requests[hostedZoneID]++
+		mu.Unlock()
+
+		serveSignature, keySigningKeys := status, ""
+		if step.keySigningKey {
+			serveSignature = tfroute53.ServeSignatureSigning
+			keySigningKeys = fmt.Sprintf(`<KeySigningKeys><member><Name>%s</Name><Status>%s</Status><StatusMessage>stub status message</StatusMessage></member></KeySigningKeys>`, keySigningKeyName, status)
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<GetDNSSECResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Status><ServeSignature>%s</ServeSignature></Status>%s</GetDNSSECResponse>`, serveSignature, keySigningKeys)
+	}))
+	t.Cleanup(server.Close)
+
+	conn := route53.New(route53.Options{
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:       endpoints.UsEast1RegionID,
+	})
+
+	for name, step := range steps {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			var (
+				got string
+				err error
+			)
+			if step.keySigningKey {
+				var output *awstypes.KeySigningKey
+				output, err = tfroute53.WaitKeySigningKeyStatusUpdated(ctx, conn, name, keySigningKeyName, step.target, time.Minute)
+				if output != nil {
+					got = aws.ToString(output.Status)
+				}
+			} else {
+				var output *awstypes.DNSSECStatus
+				output, err = tfroute53.WaitHostedZoneDNSSECStatusUpdated(ctx, conn, name, step.target, time.Minute)
+				if output != nil {
+					got = aws.ToString(output.ServeSignature)
+				}
+			}
+
+			if step.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), step.expectedErr) {
+					t.Fatalf("expected error containing %q, got: %v", step.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != step.target {
+				t.Errorf("got status %q, expected %q", got, step.target)
+			}
+		})
+	}
+}
+
 func TestAccRoute53HostedZoneDNSSEC_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	route53ZoneResourceName := "aws_route53_zone.test"
@@ -166,7 +166,7 @@ func resourceKeySigningKeyCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, hostedZoneID, name, status); err != nil {
+	if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, hostedZoneID, name, status, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) status update: %s", d.Id(), err)
 	}
 
@@ -262,7 +262,7 @@ func resourceKeySigningKeyUpdate(ctx context.Context, d *schema.ResourceData, me
 			}
 		}
 
-		if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, hostedZoneID, name, status); err != nil {
+		if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, hostedZoneID, name, status, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) status update: %s", d.Id(), err)
 		}
 	}
@@ -374,11 +374,9 @@ func statusKeySigningKey(ctx context.Context, conn *route53.Client, hostedZoneID
 	}
 }
 
-func waitKeySigningKeyStatusUpdated(ctx context.Context, conn *route53.Client, hostedZoneID, name string, status string) (*awstypes.KeySigningKey, error) { //nolint:unparam
-	const (
-		timeout = 5 * time.Minute
-	)
+func waitKeySigningKeyStatusUpdated(ctx context.Context, conn *route53.Client, hostedZoneID, name string, status string, timeout time.Duration) (*awstypes.KeySigningKey, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
+		Pending:    keySigningKeyPendingStatuses(status),
 		Target:     []string{status},
 		Refresh:    statusKeySigningKey(ctx, conn, hostedZoneID, name),
 		MinTimeout: 5 * time.Second,
@@ -397,3 +395,16 @@ func waitKeySigningKeyStatusUpdated(ctx context.Context, conn *route53.Client, h
 
 	return nil, err
 }
+
+// keySigningKeyPendingStatuses returns the key-signing key statuses that may be
+// observed while the key transitions to the specified status.
+func keySigningKeyPendingStatuses(status string) []string {
+	switch status {
+	case keySigningKeyStatusActive:
+		return []string{keySigningKeyStatusInactive}
+	case keySigningKeyStatusInactive:
+		return []string{keySigningKeyStatusActive}
+	default:
+		return nil
+	}
+}
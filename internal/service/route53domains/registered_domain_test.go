@@ -236,6 +236,15 @@ func testAccRegisteredDomain_contactPrivacy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tech_privacy", acctest.CtFalse),
 				),
 			},
+			{
+				Config: testAccRegisteredDomainConfig_contactPrivacy(domainName, false, true, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "billing_privacy", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", acctest.CtFalse),
+				),
+			},
 		},
 	})
 }
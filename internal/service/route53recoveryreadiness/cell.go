@@ -79,6 +79,7 @@ func resourceCellCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 	input := &route53recoveryreadiness.CreateCellInput{
 		CellName: aws.String(name),
 		Cells:    flex.ExpandStringValueList(d.Get("cells").([]any)),
+		Tags:     getTagsIn(ctx),
 	}
 
 	output, err := conn.CreateCell(ctx, input)
@@ -89,10 +90,6 @@ func resourceCellCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	d.SetId(aws.ToString(output.CellName))
 
-	if err := createTags(ctx, conn, aws.ToString(output.CellArn), getTagsIn(ctx)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting Route53 Recovery Readiness Cell (%s) tags: %s", d.Id(), err)
-	}
-
 	return append(diags, resourceCellRead(ctx, d, meta)...)
 }
 
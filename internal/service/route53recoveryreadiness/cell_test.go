@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53recoveryreadiness"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -142,6 +143,7 @@ func TestAccRoute53RecoveryReadinessCell_tags(t *testing.T) {
 					testAccCheckCellExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					testAccCheckTagOnCreate(ctx, resourceName, acctest.CtKey1, acctest.CtValue1),
 				),
 			},
 			{
@@ -242,6 +244,30 @@ func testAccCheckCellExists(ctx context.Context, name string) resource.TestCheck
 	}
 }
 
+// testAccCheckTagOnCreate checks a tag on the resource via the API rather than from state.
+func testAccCheckTagOnCreate(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53RecoveryReadinessClient(ctx)
+
+		tags, err := tfroute53recoveryreadiness.ListTags(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(tags.KeyValue(key)); got != value {
+			return fmt.Errorf("tag %q: got %q, want %q", key, got, value)
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53RecoveryReadinessClient(ctx)
 
@@ -14,4 +14,5 @@ var (
 	FindReadinessCheckByName = findReadinessCheckByName
 	FindRecoveryGroupByName  = findRecoveryGroupByName
 	FindResourceSetByName    = findResourceSetByName
+	ListTags                 = listTags
 )
@@ -67,6 +67,7 @@ func resourceReadinessCheckCreate(ctx context.Context, d *schema.ResourceData, m
 	input := &route53recoveryreadiness.CreateReadinessCheckInput{
 		ReadinessCheckName: aws.String(name),
 		ResourceSetName:    aws.String(d.Get("resource_set_name").(string)),
+		Tags:               getTagsIn(ctx),
 	}
 
 	output, err := conn.CreateReadinessCheck(ctx, input)
@@ -77,10 +78,6 @@ func resourceReadinessCheckCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(output.ReadinessCheckName))
 
-	if err := createTags(ctx, conn, aws.ToString(output.ReadinessCheckArn), getTagsIn(ctx)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting Route53 Recovery Readiness Readiness Check (%s) tags: %s", d.Id(), err)
-	}
-
 	return append(diags, resourceReadinessCheckRead(ctx, d, meta)...)
 }
 
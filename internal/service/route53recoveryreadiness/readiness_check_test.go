@@ -112,6 +112,7 @@ func TestAccRoute53RecoveryReadinessReadinessCheck_tags(t *testing.T) {
 					testAccCheckReadinessCheckExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					testAccCheckTagOnCreate(ctx, resourceName, acctest.CtKey1, acctest.CtValue1),
 				),
 			},
 			{
@@ -71,6 +71,7 @@ func resourceRecoveryGroupCreate(ctx context.Context, d *schema.ResourceData, me
 	input := &route53recoveryreadiness.CreateRecoveryGroupInput{
 		Cells:             flex.ExpandStringValueList(d.Get("cells").([]any)),
 		RecoveryGroupName: aws.String(name),
+		Tags:              getTagsIn(ctx),
 	}
 
 	output, err := conn.CreateRecoveryGroup(ctx, input)
@@ -81,10 +82,6 @@ func resourceRecoveryGroupCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(aws.ToString(output.RecoveryGroupName))
 
-	if err := createTags(ctx, conn, aws.ToString(output.RecoveryGroupArn), getTagsIn(ctx)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting Route53 Recovery Readiness Recovery Group (%s) tags: %s", d.Id(), err)
-	}
-
 	return append(diags, resourceRecoveryGroupRead(ctx, d, meta)...)
 }
 
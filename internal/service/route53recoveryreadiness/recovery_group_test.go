@@ -115,6 +115,7 @@ func TestAccRoute53RecoveryReadinessRecoveryGroup_tags(t *testing.T) {
 					testAccCheckRecoveryGroupExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					testAccCheckTagOnCreate(ctx, resourceName, acctest.CtKey1, acctest.CtValue1),
 				),
 			},
 			{
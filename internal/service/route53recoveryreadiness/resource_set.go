@@ -157,6 +157,7 @@ func resourceResourceSetCreate(ctx context.Context, d *schema.ResourceData, meta
 		ResourceSetName: aws.String(name),
 		ResourceSetType: aws.String(d.Get("resource_set_type").(string)),
 		Resources:       expandResourceSetResources(d.Get(names.AttrResources).([]any)),
+		Tags:            getTagsIn(ctx),
 	}
 
 	output, err := conn.CreateResourceSet(ctx, input)
@@ -167,10 +168,6 @@ func resourceResourceSetCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.ResourceSetName))
 
-	if err := createTags(ctx, conn, aws.ToString(output.ResourceSetArn), getTagsIn(ctx)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting Route53 Recovery Readiness Resource Set (%s) tags: %s", d.Id(), err)
-	}
-
 	return append(diags, resourceResourceSetRead(ctx, d, meta)...)
 }
 
@@ -112,6 +112,7 @@ func TestAccRoute53RecoveryReadinessResourceSet_tags(t *testing.T) {
 					testAccCheckResourceSetExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					testAccCheckTagOnCreate(ctx, resourceName, acctest.CtKey1, acctest.CtValue1),
 				),
 			},
 			{
@@ -79,15 +79,6 @@ func setTagsOut(ctx context.Context, tags map[string]string) {
 	}
 }
 
-// createTags creates route53recoveryreadiness service tags for new resources.
-func createTags(ctx context.Context, conn *route53recoveryreadiness.Client, identifier string, tags map[string]string, optFns ...func(*route53recoveryreadiness.Options)) error {
-	if len(tags) == 0 {
-		return nil
-	}
-
-	return updateTags(ctx, conn, identifier, nil, tags, optFns...)
-}
-
 // updateTags updates route53recoveryreadiness service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
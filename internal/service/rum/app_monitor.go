@@ -132,6 +132,36 @@ func resourceAppMonitor() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deobfuscation_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"javascript_source_maps": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_uri": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									names.AttrStatus: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.DeobfuscationStatus](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrDomain: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -179,6 +209,10 @@ func resourceAppMonitorCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.CustomEvents = expandCustomEvents(v.([]any)[0].(map[string]any))
 	}
 
+	if v, ok := d.GetOk("deobfuscation_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.DeobfuscationConfiguration = expandDeobfuscationConfiguration(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk(names.AttrDomain); ok {
 		input.Domain = aws.String(v.(string))
 	}
@@ -222,6 +256,14 @@ func resourceAppMonitorRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "setting custom_events: %s", err)
 	}
 
+	if appMon.DeobfuscationConfiguration != nil {
+		if err := d.Set("deobfuscation_configuration", []any{flattenDeobfuscationConfiguration(appMon.DeobfuscationConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deobfuscation_configuration: %s", err)
+		}
+	} else {
+		d.Set("deobfuscation_configuration", nil)
+	}
+
 	d.Set("app_monitor_id", appMon.Id)
 	name := aws.ToString(appMon.Name)
 	arn := arn.ARN{
@@ -260,6 +302,12 @@ func resourceAppMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.CustomEvents = expandCustomEvents(d.Get("custom_events").([]any)[0].(map[string]any))
 		}
 
+		if d.HasChange("deobfuscation_configuration") {
+			if v, ok := d.GetOk("deobfuscation_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+				input.DeobfuscationConfiguration = expandDeobfuscationConfiguration(v.([]any)[0].(map[string]any))
+			}
+		}
+
 		if d.HasChange("cw_log_enabled") {
 			input.CwLogEnabled = aws.Bool(d.Get("cw_log_enabled").(bool))
 		}
@@ -446,3 +494,65 @@ func flattenCustomEvents(apiObject *awstypes.CustomEvents) map[string]any {
 
 	return tfMap
 }
+
+func expandDeobfuscationConfiguration(tfMap map[string]any) *awstypes.DeobfuscationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DeobfuscationConfiguration{}
+
+	if v, ok := tfMap["javascript_source_maps"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.JavaScriptSourceMaps = expandJavaScriptSourceMaps(v[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func expandJavaScriptSourceMaps(tfMap map[string]any) *awstypes.JavaScriptSourceMaps {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.JavaScriptSourceMaps{}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		apiObject.Status = awstypes.DeobfuscationStatus(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeobfuscationConfiguration(apiObject *awstypes.DeobfuscationConfiguration) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.JavaScriptSourceMaps; v != nil {
+		tfMap["javascript_source_maps"] = []any{flattenJavaScriptSourceMaps(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJavaScriptSourceMaps(apiObject *awstypes.JavaScriptSourceMaps) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		names.AttrStatus: apiObject.Status,
+	}
+
+	if v := apiObject.S3Uri; v != nil {
+		tfMap["s3_uri"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
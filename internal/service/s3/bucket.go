@@ -18,6 +18,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -798,7 +799,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	c := meta.(*conns.AWSClient)
 	conn := c.S3Client(ctx)
 
-	_, err := findBucket(ctx, conn, d.Id())
+	// Follow any redirect to the bucket's Region and use that Region for all subsequent calls, including tags.
+	bucket, optFns, err := findBucketFollowingRegionRedirect(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -810,6 +812,10 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s): %s", d.Id(), err)
 	}
 
+	if len(optFns) > 0 {
+		conn = s3.New(conn.Options(), optFns...)
+	}
+
 	d.Set(names.AttrARN, bucketARN(ctx, c, d.Id()))
 	d.Set(names.AttrBucket, d.Id())
 	d.Set("bucket_domain_name", c.PartitionHostname(ctx, d.Id()+".s3"))
@@ -1126,16 +1132,19 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	//
 	// Bucket Region etc.
 	//
-	region, err := findBucketRegion(ctx, c, d.Id())
+	region := aws.ToString(bucket.BucketRegion)
+	if region == "" {
+		region, err = findBucketRegion(ctx, c, d.Id())
 
-	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
-	}
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) location: %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) location: %s", d.Id(), err)
+		}
 	}
 
 	d.Set("bucket_region", region)
@@ -1154,6 +1163,17 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		d.Set("website_endpoint", endpoint)
 	}
 
+	//
+	// Bucket Tags.
+	//
+	tags, err := bucketListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Bucket (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, svcTags(tags))
+
 	return diags
 }
 
@@ -1619,6 +1639,10 @@ func findBucket(ctx context.Context, conn *s3.Client, bucket string, optFns ...f
 		}
 	}
 
+	if err != nil {
+		return nil, err
+	}
+
 	if output == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
@@ -1626,6 +1650,44 @@ func findBucket(ctx context.Context, conn *s3.Client, bucket string, optFns ...f
 	return output, nil
 }
 
+// findBucketFollowingRegionRedirect is findBucket, but if S3 responds with a 301 because the bucket
+// is in a different Region to the client's it retries the request against the bucket's actual Region.
+// The returned options target that Region and should be passed to any subsequent calls for the bucket.
+func findBucketFollowingRegionRedirect(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, []func(*s3.Options), error) {
+	output, err := findBucket(ctx, conn, bucket, optFns...)
+
+	if !tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusMovedPermanently) {
+		return output, optFns, err
+	}
+
+	region := bucketRegionFromError(err)
+	if region == "" {
+		return nil, nil, err
+	}
+
+	log.Printf("[DEBUG] S3 Bucket (%s) is in Region %s, retrying", bucket, region)
+	optFns = append(slices.Clone(optFns), func(o *s3.Options) {
+		o.Region = region
+	})
+
+	output, err = findBucket(ctx, conn, bucket, optFns...)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return output, optFns, nil
+}
+
+// bucketRegionFromError returns the value of the x-amz-bucket-region header from an S3 error response.
+func bucketRegionFromError(err error) string {
+	if respErr, ok := errs.As[*awshttp.ResponseError](err); ok && respErr.Response != nil {
+		return respErr.Response.Header.Get("X-Amz-Bucket-Region")
+	}
+
+	return ""
+}
+
 func findBucketRegion(ctx context.Context, c *conns.AWSClient, bucket string, optFns ...func(*s3.Options)) (string, error) {
 	optFns = append(slices.Clone(optFns),
 		func(o *s3.Options) {
@@ -73,7 +73,8 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta any)
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
 
-	_, err := findBucket(ctx, conn, bucket, optFns...)
+	// Follow any redirect to the bucket's Region and use that Region for subsequent calls.
+	_, optFns, err := findBucketFollowingRegionRedirect(ctx, conn, bucket, optFns...)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s): %s", bucket, err)
@@ -99,7 +100,7 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta any)
 	} else {
 		log.Printf("[WARN] HostedZoneIDForRegion: %s", err)
 	}
-	if _, err := findBucketWebsite(ctx, conn, bucket, ""); err == nil {
+	if _, err := findBucketWebsite(ctx, conn, bucket, "", optFns...); err == nil {
 		endpoint, domain := bucketWebsiteEndpointAndDomain(bucket, region)
 		d.Set("website_domain", domain)
 		d.Set("website_endpoint", endpoint)
@@ -8,14 +8,18 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	cloudformationtypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -2564,6 +2568,100 @@ func TestWebsiteEndpoint(t *testing.T) {
 	}
 }
 
+func TestFindBucketFollowingRegionRedirect(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const (
+		bucket       = "bucket-name"
+		bucketRegion = endpoints.UsWest2RegionID
+	)
+
+	var (
+		mu             sync.Mutex
+		requestRegions []string
+	)
+	takeRequestRegions := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		v := requestRegions
+		requestRegions = nil
+		return v
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The signing Region is in the SigV4 credential scope, e.g. "Credential=AKID/20060102/us-west-2/s3/aws4_request".
+		parts := strings.SplitN(r.Header.Get("Authorization"), "Credential=", 2)
+		if len(parts) != 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		scope := strings.Split(parts[1], "/")
+		if len(scope) < 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		region := scope[2]
+
+		mu.Lock()
+		requestRegions = append(requestRegions, region)
+		mu.Unlock()
+
+		w.Header().Set("X-Amz-Bucket-Region", bucketRegion)
+		if region != bucketRegion {
+			w.WriteHeader(http.StatusMovedPermanently)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	conn := s3.New(s3.Options{
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:       endpoints.UsEast1RegionID,
+		UsePathStyle: true,
+	})
+
+	_, err := tfs3.FindBucket(ctx, conn, bucket)
+	if !tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusMovedPermanently) {
+		t.Fatalf("expected HTTP 301 error from FindBucket, got: %v", err)
+	}
+
+	takeRequestRegions()
+	output, optFns, err := tfs3.FindBucketFollowingRegionRedirect(ctx, conn, bucket)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.BucketRegion), bucketRegion; got != want {
+		t.Errorf("BucketRegion = %q, want %q", got, want)
+	}
+
+	if got, want := takeRequestRegions(), []string{endpoints.UsEast1RegionID, bucketRegion}; !slices.Equal(got, want) {
+		t.Errorf("request Regions = %v, want %v", got, want)
+	}
+
+	// Subsequent calls with the returned options go straight to the bucket's Region.
+	if _, err := tfs3.FindBucket(ctx, conn, bucket, optFns...); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := takeRequestRegions(), []string{bucketRegion}; !slices.Equal(got, want) {
+		t.Errorf("request Regions = %v, want %v", got, want)
+	}
+
+	// As do calls made with a client built from the returned options, as used for the remaining reads and tags.
+	if _, err := tfs3.FindBucket(ctx, s3.New(conn.Options(), optFns...), bucket); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := takeRequestRegions(), []string{bucketRegion}; !slices.Equal(got, want) {
+		t.Errorf("request Regions = %v, want %v", got, want)
+	}
+}
+
 func testAccCheckBucketDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error { return testAccCheckBucketDestroyWithProvider(ctx)(s, acctest.Provider) }
 }
@@ -6,6 +6,7 @@ package s3
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -134,6 +135,38 @@ func (r *directoryBucketResource) Schema(ctx context.Context, request resource.S
 	}
 }
 
+func (r *directoryBucketResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data directoryBucketResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Bucket.IsUnknown() || data.Location.IsNull() || data.Location.IsUnknown() {
+		return
+	}
+
+	locationInfoData, diags := data.Location.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || locationInfoData == nil || locationInfoData.Name.IsUnknown() {
+		return
+	}
+
+	// The bucket name's zone ID suffix must match the location, e.g. example--usw2-az2--x-s3 must be in usw2-az2.
+	bucket, locationName := data.Bucket.ValueString(), locationInfoData.Name.ValueString()
+	if !directoryBucketNameRegex.MatchString(bucket) {
+		return
+	}
+
+	if !strings.HasSuffix(bucket, "--"+locationName+"--x-s3") {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrBucket),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("bucket name (%s) must end with the location name (%s) followed by --x-s3", bucket, locationName),
+		)
+	}
+}
+
 func (r *directoryBucketResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data directoryBucketResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
@@ -112,6 +112,24 @@ func TestAccS3DirectoryBucket_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3DirectoryBucket_locationMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDirectoryBucketPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectoryBucketConfig_locationMismatch(rName),
+				ExpectError: regexache.MustCompile(`must end with the location name`),
+			},
+		},
+	})
+}
+
 func TestAccS3DirectoryBucket_forceDestroy(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -305,6 +323,18 @@ resource "aws_s3_directory_bucket" "test" {
 `)
 }
 
+func testAccDirectoryBucketConfig_locationMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = "%[1]s--usw2-az1--x-s3"
+
+  location {
+    name = "usw2-az2"
+  }
+}
+`, rName)
+}
+
 func testAccDirectoryBucketConfig_forceDestroy(rName string) string {
 	return acctest.ConfigCompose(testAccDirectoryBucketConfig_baseAZ(rName), `
 resource "aws_s3_directory_bucket" "test" {
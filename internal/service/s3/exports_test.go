@@ -36,6 +36,7 @@ var (
 	EmptyBucket                                 = emptyBucket
 	FindAnalyticsConfiguration                  = findAnalyticsConfiguration
 	FindBucket                                  = findBucket
+	FindBucketFollowingRegionRedirect           = findBucketFollowingRegionRedirect
 	FindBucketACL                               = findBucketACL
 	FindBucketAccelerateConfiguration           = findBucketAccelerateConfiguration
 	FindBucketLifecycleConfiguration            = findBucketLifecycleConfiguration
@@ -1388,6 +1388,48 @@ func resourceDomain() *schema.Resource {
 							MaxItems: 3,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"unified_studio_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain_account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"domain_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"domain_region": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+									"environment_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"project_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"project_s3_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"studio_web_portal_access": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.FeatureStatus](),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -1675,6 +1717,10 @@ func expandDomainSettings(l []any) *awstypes.DomainSettings {
 		config.RStudioServerProDomainSettings = expandRStudioServerProDomainSettings(v)
 	}
 
+	if v, ok := m["unified_studio_settings"].([]any); ok && len(v) > 0 {
+		config.UnifiedStudioSettings = expandUnifiedStudioSettings(v)
+	}
+
 	return config
 }
 
@@ -1698,6 +1744,46 @@ func expandDockerSettings(l []any) *awstypes.DockerSettings {
 	return config
 }
 
+func expandUnifiedStudioSettings(l []any) *awstypes.UnifiedStudioSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]any)
+
+	config := &awstypes.UnifiedStudioSettings{}
+
+	if v, ok := m["domain_account_id"].(string); ok && v != "" {
+		config.DomainAccountId = aws.String(v)
+	}
+
+	if v, ok := m["domain_id"].(string); ok && v != "" {
+		config.DomainId = aws.String(v)
+	}
+
+	if v, ok := m["domain_region"].(string); ok && v != "" {
+		config.DomainRegion = aws.String(v)
+	}
+
+	if v, ok := m["environment_id"].(string); ok && v != "" {
+		config.EnvironmentId = aws.String(v)
+	}
+
+	if v, ok := m["project_id"].(string); ok && v != "" {
+		config.ProjectId = aws.String(v)
+	}
+
+	if v, ok := m["project_s3_path"].(string); ok && v != "" {
+		config.ProjectS3Path = aws.String(v)
+	}
+
+	if v, ok := m["studio_web_portal_access"].(string); ok && v != "" {
+		config.StudioWebPortalAccess = awstypes.FeatureStatus(v)
+	}
+
+	return config
+}
+
 func expandRStudioServerProDomainSettings(l []any) *awstypes.RStudioServerProDomainSettings {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1751,6 +1837,10 @@ func expandDomainSettingsUpdate(l []any) *awstypes.DomainSettingsForUpdate {
 		config.RStudioServerProDomainSettingsForUpdate = expandRStudioServerProDomainSettingsUpdate(v)
 	}
 
+	if v, ok := m["unified_studio_settings"].([]any); ok && len(v) > 0 {
+		config.UnifiedStudioSettings = expandUnifiedStudioSettings(v)
+	}
+
 	return config
 }
 
@@ -3028,6 +3118,25 @@ func flattenDomainSettings(config *awstypes.DomainSettings) []map[string]any {
 		"execution_role_identity_config":      config.ExecutionRoleIdentityConfig,
 		"r_studio_server_pro_domain_settings": flattenRStudioServerProDomainSettings(config.RStudioServerProDomainSettings),
 		names.AttrSecurityGroupIDs:            flex.FlattenStringValueSet(config.SecurityGroupIds),
+		"unified_studio_settings":             flattenUnifiedStudioSettings(config.UnifiedStudioSettings),
+	}
+
+	return []map[string]any{m}
+}
+
+func flattenUnifiedStudioSettings(config *awstypes.UnifiedStudioSettings) []map[string]any {
+	if config == nil {
+		return []map[string]any{}
+	}
+
+	m := map[string]any{
+		"domain_account_id":        aws.ToString(config.DomainAccountId),
+		"domain_id":                aws.ToString(config.DomainId),
+		"domain_region":            aws.ToString(config.DomainRegion),
+		"environment_id":           aws.ToString(config.EnvironmentId),
+		"project_id":               aws.ToString(config.ProjectId),
+		"project_s3_path":          aws.ToString(config.ProjectS3Path),
+		"studio_web_portal_access": config.StudioWebPortalAccess,
 	}
 
 	return []map[string]any{m}
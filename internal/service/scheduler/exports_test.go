@@ -6,5 +6,6 @@ package scheduler
 // Exports for use in tests only.
 var (
 	FindScheduleByTwoPartKey = findScheduleByTwoPartKey
+	IsIAMNotPropagatedError  = isIAMNotPropagatedError
 	ResourceSchedule         = resourceSchedule
 )
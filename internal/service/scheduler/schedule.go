@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	iamwait "github.com/hashicorp/terraform-provider-aws/internal/iam/wait"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -490,9 +491,9 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta an
 		in.Target = expandTarget(ctx, v[0].(map[string]any))
 	}
 
-	out, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.CreateScheduleOutput, error) {
+	out, err := iamwait.RetryWhenNotPropagated(ctx, func(ctx context.Context) (*scheduler.CreateScheduleOutput, error) {
 		return conn.CreateSchedule(ctx, in)
-	})
+	}, isIAMNotPropagatedError)
 
 	if err != nil {
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameSchedule, name, err)
@@ -622,9 +623,9 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	log.Printf("[DEBUG] Updating EventBridge Scheduler Schedule (%s): %#v", d.Id(), in)
 
-	_, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.UpdateScheduleOutput, error) {
+	_, err := iamwait.RetryWhenNotPropagated(ctx, func(ctx context.Context) (*scheduler.UpdateScheduleOutput, error) {
 		return conn.UpdateSchedule(ctx, in)
-	})
+	}, isIAMNotPropagatedError)
 
 	if err != nil {
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedule, d.Id(), err)
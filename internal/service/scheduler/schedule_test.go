@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -178,6 +179,37 @@ func TestResourceScheduleParseID(t *testing.T) {
 	}
 }
 
+func TestIsIAMNotPropagatedError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {},
+		"execution role not assumable": {
+			err:      &awstypes.ValidationException{Message: aws.String("The execution role you provide must allow AWS EventBridge Scheduler to assume the role.")},
+			expected: true,
+		},
+		"other validation error": {
+			err: &awstypes.ValidationException{Message: aws.String("Invalid request provided: Schedule expression at(2023-01-01T00:00:00) is in the past.")},
+		},
+		"other error": {
+			err: errors.New("The execution role you provide must allow AWS EventBridge Scheduler to assume the role."),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfscheduler.IsIAMNotPropagatedError(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsIAMNotPropagatedError() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccSchedulerSchedule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
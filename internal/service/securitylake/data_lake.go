@@ -14,7 +14,9 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -106,6 +109,9 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 						},
 						names.AttrRegion: schema.StringAttribute{
 							Required: true,
+							Validators: []validator.String{
+								fwvalidators.AWSRegion(),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -125,6 +131,9 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 											Attributes: map[string]schema.Attribute{
 												"days": schema.Int64Attribute{
 													Optional: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
 												},
 											},
 										},
@@ -135,6 +144,9 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 											Attributes: map[string]schema.Attribute{
 												"days": schema.Int64Attribute{
 													Optional: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
 												},
 												names.AttrStorageClass: schema.StringAttribute{
 													Optional: true,
@@ -156,6 +168,9 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 										CustomType:  fwtypes.SetOfStringType,
 										ElementType: types.StringType,
 										Optional:    true,
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(fwvalidators.AWSRegion()),
+										},
 									},
 									names.AttrRoleARN: schema.StringAttribute{
 										CustomType: fwtypes.ARNType,
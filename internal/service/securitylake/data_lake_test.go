@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -335,6 +336,28 @@ func testAccDataLake_lifeCycle(t *testing.T) {
 	})
 }
 
+func testAccDataLake_lifeCycleInvalidDays(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataLakeConfig_lifeCycleDays(rName, 0),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
 func testAccDataLake_metaStoreUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var datalake types.DataLakeResource
@@ -845,6 +868,32 @@ resource "aws_securitylake_data_lake" "test" {
 `, rName, acctest.Region()))
 }
 
+func testAccDataLakeConfig_lifeCycleDays(rName string, days int) string {
+	return acctest.ConfigCompose(
+		testAccDataLakeConfigConfig_base,
+		fmt.Sprintf(`
+resource "aws_securitylake_data_lake" "test" {
+  meta_store_manager_role_arn = aws_iam_role.meta_store_manager.arn
+
+  configuration {
+    region = %[2]q
+
+    lifecycle_configuration {
+      expiration {
+        days = %[3]d
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.datalake]
+}
+`, rName, acctest.Region(), days))
+}
+
 func testAccDataLakeConfig_lifeCycleUpdate(rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataLakeConfigConfig_base,
@@ -49,6 +49,7 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			acctest.CtDisappears: testAccDataLake_disappears,
 			"tags":               testAccDataLake_tags,
 			"lifecycle":          testAccDataLake_lifeCycle,
+			"lifecycleInvalid":   testAccDataLake_lifeCycleInvalidDays,
 			"metaStoreUpdate":    testAccDataLake_metaStoreUpdate,
 			"replication":        testAccDataLake_replication,
 			"Identity":           testAccDataLake_IdentitySerial,
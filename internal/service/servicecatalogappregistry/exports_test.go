@@ -8,8 +8,10 @@ var (
 	FindApplicationByID                       = findApplicationByID
 	FindAttributeGroupByID                    = findAttributeGroupByID
 	FindAttributeGroupAssociationByTwoPartKey = findAttributeGroupAssociationByTwoPartKey
+	FindResourceAssociationByThreePartKey     = findResourceAssociationByThreePartKey
 
 	ResourceApplication               = newApplicationResource
 	ResourceAttributeGroup            = newAttributeGroupResource
 	ResourceAttributeGroupAssociation = newAttributeGroupAssociationResource
+	ResourceResourceAssociation       = newResourceAssociationResource
 )
@@ -72,6 +72,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Attribute Group Association",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newResourceAssociationResource,
+			TypeName: "aws_servicecatalogappregistry_resource_association",
+			Name:     "Resource Association",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
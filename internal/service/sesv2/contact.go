@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sesv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sesv2_contact", name="Contact")
+// @Testing(serialize=true)
+func resourceContact() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactCreate,
+		ReadWithoutTimeout:   resourceContactRead,
+		UpdateWithoutTimeout: resourceContactUpdate,
+		DeleteWithoutTimeout: resourceContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attributes_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"contact_list_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"topic_default_preferences": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     topicPreferenceSchema(),
+			},
+			"topic_preferences": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     topicPreferenceSchema(),
+			},
+			"unsubscribe_all": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func topicPreferenceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"subscription_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.SubscriptionStatus](),
+			},
+			"topic_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+const (
+	resNameContact = "Contact"
+)
+
+func resourceContactCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	contactListName, emailAddress := d.Get("contact_list_name").(string), d.Get("email_address").(string)
+	id := contactCreateResourceID(contactListName, emailAddress)
+	in := &sesv2.CreateContactInput{
+		ContactListName: aws.String(contactListName),
+		EmailAddress:    aws.String(emailAddress),
+		UnsubscribeAll:  d.Get("unsubscribe_all").(bool),
+	}
+
+	if v, ok := d.GetOk("attributes_data"); ok {
+		in.AttributesData = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("topic_preferences"); ok && v.(*schema.Set).Len() > 0 {
+		in.TopicPreferences = expandTopicPreferences(v.(*schema.Set).List())
+	}
+
+	_, err := conn.CreateContact(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionCreating, resNameContact, id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceContactRead(ctx, d, meta)...)
+}
+
+func resourceContactRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	contactListName, emailAddress, err := contactParseResourceID(d.Id())
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, resNameContact, d.Id(), err)
+	}
+
+	out, err := findContactByTwoPartKey(ctx, conn, contactListName, emailAddress)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESV2 Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, resNameContact, d.Id(), err)
+	}
+
+	d.Set("attributes_data", out.AttributesData)
+	d.Set("contact_list_name", out.ContactListName)
+	d.Set("created_timestamp", aws.ToTime(out.CreatedTimestamp).Format(time.RFC3339))
+	d.Set("email_address", out.EmailAddress)
+	d.Set("last_updated_timestamp", aws.ToTime(out.LastUpdatedTimestamp).Format(time.RFC3339))
+	d.Set("unsubscribe_all", out.UnsubscribeAll)
+
+	if err := d.Set("topic_default_preferences", flattenTopicPreferences(out.TopicDefaultPreferences)); err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionSetting, resNameContact, d.Id(), err)
+	}
+
+	if err := d.Set("topic_preferences", flattenTopicPreferences(out.TopicPreferences)); err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionSetting, resNameContact, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceContactUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	contactListName, emailAddress, err := contactParseResourceID(d.Id())
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionUpdating, resNameContact, d.Id(), err)
+	}
+
+	if d.HasChanges("attributes_data", "topic_preferences", "unsubscribe_all") {
+		in := &sesv2.UpdateContactInput{
+			AttributesData:   aws.String(d.Get("attributes_data").(string)),
+			ContactListName:  aws.String(contactListName),
+			EmailAddress:     aws.String(emailAddress),
+			TopicPreferences: expandTopicPreferences(d.Get("topic_preferences").(*schema.Set).List()),
+			UnsubscribeAll:   d.Get("unsubscribe_all").(bool),
+		}
+
+		if _, err := conn.UpdateContact(ctx, in); err != nil {
+			return create.AppendDiagError(diags, names.SESV2, create.ErrActionUpdating, resNameContact, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContactRead(ctx, d, meta)...)
+}
+
+func resourceContactDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	contactListName, emailAddress, err := contactParseResourceID(d.Id())
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionDeleting, resNameContact, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting SESV2 Contact %s", d.Id())
+
+	_, err = conn.DeleteContact(ctx, &sesv2.DeleteContactInput{
+		ContactListName: aws.String(contactListName),
+		EmailAddress:    aws.String(emailAddress),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionDeleting, resNameContact, d.Id(), err)
+	}
+
+	return diags
+}
+
+const contactResourceIDSeparator = "|"
+
+func contactCreateResourceID(contactListName, emailAddress string) string {
+	parts := []string{contactListName, emailAddress}
+	id := strings.Join(parts, contactResourceIDSeparator)
+
+	return id
+}
+
+func contactParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, contactResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected CONTACT_LIST_NAME%[2]sEMAIL_ADDRESS", id, contactResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findContactByTwoPartKey(ctx context.Context, conn *sesv2.Client, contactListName, emailAddress string) (*sesv2.GetContactOutput, error) {
+	input := &sesv2.GetContactInput{
+		ContactListName: aws.String(contactListName),
+		EmailAddress:    aws.String(emailAddress),
+	}
+
+	return findContact(ctx, conn, input)
+}
+
+func findContact(ctx context.Context, conn *sesv2.Client, input *sesv2.GetContactInput) (*sesv2.GetContactOutput, error) {
+	output, err := conn.GetContact(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandTopicPreferences(tfList []any) []types.TopicPreference {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.TopicPreference
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.TopicPreference{}
+
+		if v, ok := tfMap["subscription_status"].(string); ok && v != "" {
+			apiObject.SubscriptionStatus = types.SubscriptionStatus(v)
+		}
+
+		if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+			apiObject.TopicName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTopicPreferences(apiObjects []types.TopicPreference) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"subscription_status": string(apiObject.SubscriptionStatus),
+			"topic_name":          aws.ToString(apiObject.TopicName),
+		})
+	}
+
+	return tfList
+}
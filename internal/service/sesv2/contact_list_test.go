@@ -27,6 +27,9 @@ func TestAccSESV2ContactList_serial(t *testing.T) {
 		"tags":               testAccSESV2ContactList_tagsSerial,
 		"description":        testAccContactList_description,
 		"topic":              testAccContactList_topic,
+		"contactBasic":       testAccContact_basic,
+		"contactDisappears":  testAccContact_disappears,
+		"contactUpdate":      testAccContact_update,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -93,6 +93,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Configuration Set Event Destination",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceContact,
+			TypeName: "aws_sesv2_contact",
+			Name:     "Contact",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceContactList,
 			TypeName: "aws_sesv2_contact_list",
@@ -152,7 +152,7 @@ func resourceStateMachine() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"state_machine_version_arn": {
 				Type:     schema.TypeString,
@@ -612,6 +612,28 @@ func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_invalidRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_roleARN(rName, "arn:aws:iam::123456789012:policy/David"), // lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`expected resource type to be one of \["role"\]`),
+			},
+			{
+				Config:      testAccStateMachineConfig_roleARN(rName, "not-an-arn"),
+				ExpectError: regexache.MustCompile(`is an invalid ARN`),
+			},
+		},
+	})
+}
+
 func testAccCheckStateMachineExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1255,6 +1277,25 @@ EOF
 `, rName, rType))
 }
 
+func testAccStateMachineConfig_roleARN(rName, roleARN string) string {
+	return fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = %[2]q
+
+  definition = jsonencode({
+    StartAt = "Pass"
+    States = {
+      Pass = {
+        Type = "Pass"
+        End  = true
+      }
+    }
+  })
+}
+`, rName, roleARN)
+}
+
 func testAccStateMachineConfig_invalidDefinition(rName string) string {
 	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
 resource "aws_sfn_state_machine" "test" {
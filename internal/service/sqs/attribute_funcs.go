@@ -5,7 +5,11 @@ package sqs
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"reflect"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -129,3 +133,60 @@ func (h *queueAttributeHandler) Delete(ctx context.Context, d *schema.ResourceDa
 
 	return diags
 }
+
+// suppressEquivalentRedrivePolicyDiffs suppresses differences between semantically equal
+// redrive_policy or redrive_allow_policy JSON documents.
+func suppressEquivalentRedrivePolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return redrivePoliciesEquivalent(old, new)
+}
+
+// redrivePolicyToSet returns the existing redrive policy if it is semantically equal to the new one.
+func redrivePolicyToSet(old, new string) (string, error) {
+	if redrivePoliciesEquivalent(old, new) {
+		return old, nil
+	}
+
+	return new, nil
+}
+
+// redrivePoliciesEquivalent reports whether two redrive (or redrive allow) policy JSON documents are
+// semantically equal. Key order, numbers encoded as strings and the order of source queue ARNs are ignored.
+func redrivePoliciesEquivalent(s1, s2 string) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	if s1 == "" || s2 == "" {
+		return false
+	}
+
+	var m1, m2 map[string]any
+
+	if err := json.Unmarshal([]byte(s1), &m1); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(s2), &m2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalizeRedrivePolicy(m1), normalizeRedrivePolicy(m2))
+}
+
+func normalizeRedrivePolicy(m map[string]any) map[string]any {
+	for k, v := range m {
+		switch v := v.(type) {
+		case float64:
+			m[k] = fmt.Sprint(v)
+		case []any:
+			arns := make([]string, 0, len(v))
+			for _, arn := range v {
+				arns = append(arns, fmt.Sprint(arn))
+			}
+			slices.Sort(arns)
+			m[k] = arns
+		}
+	}
+
+	return m
+}
@@ -133,20 +133,24 @@ var (
 			Default:  defaultQueueReceiveMessageWaitTimeSeconds,
 		},
 		"redrive_allow_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringIsJSON,
+			Type:                  schema.TypeString,
+			Optional:              true,
+			Computed:              true,
+			ValidateFunc:          validation.StringIsJSON,
+			DiffSuppressFunc:      suppressEquivalentRedrivePolicyDiffs,
+			DiffSuppressOnRefresh: true,
 			StateFunc: func(v any) string {
 				json, _ := structure.NormalizeJsonString(v)
 				return json
 			},
 		},
 		"redrive_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringIsJSON,
+			Type:                  schema.TypeString,
+			Optional:              true,
+			Computed:              true,
+			ValidateFunc:          validation.StringIsJSON,
+			DiffSuppressFunc:      suppressEquivalentRedrivePolicyDiffs,
+			DiffSuppressOnRefresh: true,
 			StateFunc: func(v any) string {
 				json, _ := structure.NormalizeJsonString(v)
 				return json
@@ -571,7 +575,7 @@ func statusQueueAttributeState(ctx context.Context, conn *sqs.Client, url string
 						return queueAttributeStateNotEqual
 					}
 				case types.QueueAttributeNameRedriveAllowPolicy, types.QueueAttributeNameRedrivePolicy:
-					if !verify.JSONStringsEqual(g, e) {
+					if !redrivePoliciesEquivalent(g, e) {
 						return queueAttributeStateNotEqual
 					}
 				default:
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_sqs_queue_redrive_allow_policy", name="Queue Redrive Allow Policy")
@@ -19,12 +18,7 @@ func resourceQueueRedriveAllowPolicy() *schema.Resource {
 	h := &queueAttributeHandler{
 		AttributeName: types.QueueAttributeNameRedriveAllowPolicy,
 		SchemaKey:     "redrive_allow_policy",
-		ToSet: func(old, new string) (string, error) {
-			if verify.JSONBytesEqual([]byte(old), []byte(new)) {
-				return old, nil
-			}
-			return new, nil
-		},
+		ToSet:         redrivePolicyToSet,
 	}
 
 	return &schema.Resource{
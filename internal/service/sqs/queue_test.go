@@ -93,6 +93,81 @@ func TestQueueNameFromURL(t *testing.T) {
 	}
 }
 
+func TestRedrivePoliciesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Policy1  string
+		Policy2  string
+		Expected bool
+	}{
+		{
+			Name:     "identical",
+			Policy1:  `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			Policy2:  `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			Name:     "reordered keys",
+			Policy1:  `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":4}`,  //lintignore:AWSAT003,AWSAT005
+			Policy2:  `{"maxReceiveCount":4, "deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq"}`, //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			Name:     "number as string",
+			Policy1:  `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":"4"}`, //lintignore:AWSAT003,AWSAT005
+			Policy2:  `{"maxReceiveCount":4,"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq"}`,   //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			Name:     "different max receive count",
+			Policy1:  `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			Policy2:  `{"maxReceiveCount":5,"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq"}`, //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			Name:     "different dead-letter queue",
+			Policy1:  `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq1","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			Policy2:  `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq2","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			Name:     "reordered source queue ARNs",
+			Policy1:  `{"redrivePermission":"byQueue","sourceQueueArns":["arn:aws:sqs:us-west-2:123456789012:q1","arn:aws:sqs:us-west-2:123456789012:q2"]}`, //lintignore:AWSAT003,AWSAT005
+			Policy2:  `{"sourceQueueArns":["arn:aws:sqs:us-west-2:123456789012:q2","arn:aws:sqs:us-west-2:123456789012:q1"],"redrivePermission":"byQueue"}`, //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			Name:     "different redrive permission",
+			Policy1:  `{"redrivePermission":"allowAll"}`,
+			Policy2:  `{"redrivePermission":"denyAll"}`,
+			Expected: false,
+		},
+		{
+			Name:     "one empty",
+			Policy1:  `{"redrivePermission":"allowAll"}`,
+			Expected: false,
+		},
+		{
+			Name:     "invalid JSON",
+			Policy1:  `{"redrivePermission":"allowAll"}`,
+			Policy2:  `{`,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfsqs.RedrivePoliciesEquivalent(testCase.Policy1, testCase.Policy2), testCase.Expected; got != want {
+				t.Errorf("RedrivePoliciesEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccSQSQueue_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -235,6 +235,7 @@ func resourcePatchBaseline() *schema.Resource {
 					"approved_patches_compliance_level",
 					"rejected_patches_action",
 					"approved_patches_enable_non_security",
+					"available_security_updates_compliance_status",
 					names.AttrSource,
 				) {
 					return d.SetNewComputed(names.AttrJSON)
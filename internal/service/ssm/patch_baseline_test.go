@@ -434,12 +434,25 @@ func TestAccSSMPatchBaseline_availableSecurityUpdatesComplianceStatus(t *testing
 			},
 			{
 				Config: testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(name, string(awstypes.PatchComplianceStatusNonCompliant)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New(names.AttrJSON)),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPatchBaselineExists(ctx, resourceName, &after),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ssm", regexache.MustCompile(`patchbaseline/pb-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "available_security_updates_compliance_status", string(awstypes.PatchComplianceStatusNonCompliant)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Baseline"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, fmt.Sprintf("patch-baseline-%s", name)),
+					acctest.CheckResourceAttrJMES(resourceName, names.AttrJSON, "AvailableSecurityUpdatesComplianceStatus", string(awstypes.PatchComplianceStatusNonCompliant)),
+					func(*terraform.State) error {
+						if aws.ToString(before.BaselineId) != aws.ToString(after.BaselineId) {
+							t.Fatal("Baseline IDs changed unexpectedly")
+						}
+						return nil
+					},
 				),
 			},
 		},
@@ -140,7 +140,14 @@ func resourcePatchGroupDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func findPatchGroupByTwoPartKey(ctx context.Context, conn *ssm.Client, patchGroup, baselineID string) (*awstypes.PatchGroupPatchBaselineMapping, error) {
-	input := &ssm.DescribePatchGroupsInput{}
+	input := &ssm.DescribePatchGroupsInput{
+		Filters: []awstypes.PatchOrchestratorFilter{
+			{
+				Key:    aws.String("NAME_PREFIX"),
+				Values: []string{patchGroup},
+			},
+		},
+	}
 
 	return findPatchGroup(ctx, conn, input, func(v *awstypes.PatchGroupPatchBaselineMapping) bool {
 		if aws.ToString(v.PatchGroup) == patchGroup {
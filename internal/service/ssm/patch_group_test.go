@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -63,6 +65,40 @@ func TestAccSSMPatchGroup_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchGroup_deregisteredOutOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_patch_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchGroupExists(ctx, resourceName),
+					testAccCheckPatchGroupDeregister(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPatchGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchGroupExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMPatchGroup_multipleBaselines(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -167,6 +203,26 @@ func testAccCheckPatchGroupExists(ctx context.Context, n string) resource.TestCh
 	}
 }
 
+// testAccCheckPatchGroupDeregister removes the patch group registration directly,
+// leaving the patch baseline in place.
+func testAccCheckPatchGroupDeregister(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := conn.DeregisterPatchBaselineForPatchGroup(ctx, &ssm.DeregisterPatchBaselineForPatchGroupInput{
+			BaselineId: aws.String(rs.Primary.Attributes["baseline_id"]),
+			PatchGroup: aws.String(rs.Primary.Attributes["patch_group"]),
+		})
+
+		return err
+	}
+}
+
 func testAccPatchGroupConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_patch_baseline" "test" {
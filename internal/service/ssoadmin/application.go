@@ -60,6 +60,9 @@ func (r *applicationResource) Schema(ctx context.Context, request resource.Schem
 			"application_provider_arn": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
 				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
 			"client_token": schema.StringAttribute{
@@ -72,6 +75,9 @@ func (r *applicationResource) Schema(ctx context.Context, request resource.Schem
 			"instance_arn": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
 				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			names.AttrName: schema.StringAttribute{
 				Required: true,
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -26,7 +27,8 @@ import (
 )
 
 const (
-	testAccApplicationProviderARN = "arn:aws:sso::aws:applicationProvider/custom" //lintignore:AWSAT005
+	testAccApplicationProviderARN     = "arn:aws:sso::aws:applicationProvider/custom"      //lintignore:AWSAT005
+	testAccApplicationProviderARNSAML = "arn:aws:sso::aws:applicationProvider/custom-saml" //lintignore:AWSAT005
 )
 
 func TestAccSSOAdminApplication_basic(t *testing.T) {
@@ -95,6 +97,45 @@ func TestAccSSOAdminApplication_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminApplication_applicationProviderARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application ssoadmin.DescribeApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, testAccApplicationProviderARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_provider_arn", testAccApplicationProviderARN),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_basic(rName, testAccApplicationProviderARNSAML),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_provider_arn", testAccApplicationProviderARNSAML),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminApplication_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var application ssoadmin.DescribeApplicationOutput
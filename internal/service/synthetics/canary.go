@@ -84,6 +84,21 @@ func ResourceCanary() *schema.Resource {
 					return strings.TrimPrefix(new, "s3://") == old
 				},
 			},
+			"browser_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"browser_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.BrowserType](),
+						},
+					},
+				},
+			},
 			"delete_lambda": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -324,6 +339,10 @@ func resourceCanaryCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.ArtifactConfig = expandCanaryArtifactConfig(v.([]any))
 	}
 
+	if v, ok := d.GetOk("browser_config"); ok {
+		input.BrowserConfigs = expandCanaryBrowserConfigs(v.([]any))
+	}
+
 	if v, ok := d.GetOk(names.AttrSchedule); ok {
 		input.Schedule = expandCanarySchedule(v.([]any))
 	}
@@ -409,6 +428,9 @@ func resourceCanaryRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	}.String()
 	d.Set(names.AttrARN, canaryArn)
 	d.Set("artifact_s3_location", canary.ArtifactS3Location)
+	if err := d.Set("browser_config", flattenCanaryBrowserConfigs(canary.BrowserConfigs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting browser_config: %s", err)
+	}
 	if len(canary.EngineConfigs) > 0 {
 		d.Set("engine_arn", canary.EngineConfigs[0].EngineArn)
 	} else {
@@ -470,6 +492,10 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.ArtifactConfig = expandCanaryArtifactConfig(d.Get("artifact_config").([]any))
 		}
 
+		if d.HasChange("browser_config") {
+			input.BrowserConfigs = expandCanaryBrowserConfigs(d.Get("browser_config").([]any))
+		}
+
 		if d.HasChange("runtime_version") {
 			input.RuntimeVersion = aws.String(d.Get("runtime_version").(string))
 		}
@@ -841,6 +867,43 @@ func expandCanaryVPCConfig(l []any) *awstypes.VpcConfigInput {
 	return codeConfig
 }
 
+func expandCanaryBrowserConfigs(l []any) []awstypes.BrowserConfig {
+	if len(l) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.BrowserConfig
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.BrowserConfig{
+			BrowserType: awstypes.BrowserType(m["browser_type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenCanaryBrowserConfigs(apiObjects []awstypes.BrowserConfig) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"browser_type": apiObject.BrowserType,
+		})
+	}
+
+	return tfList
+}
+
 func flattenCanaryTimeline(timeline *awstypes.CanaryTimeline) []any {
 	if timeline == nil {
 		return []any{}
@@ -679,6 +679,45 @@ func TestAccSyntheticsCanary_runConfigEphemeralStorage(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_browserConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_browserConfig1(rName, "CHROME"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "browser_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "browser_config.0.browser_type", "CHROME"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+			},
+			{
+				Config: testAccCanaryConfig_browserConfig2(rName, "CHROME", "FIREFOX"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "browser_config.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "browser_config.0.browser_type", "CHROME"),
+					resource.TestCheckResourceAttr(resourceName, "browser_config.1.browser_type", "FIREFOX"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_scheduleRetryConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf1, conf2 awstypes.Canary
@@ -1251,6 +1290,62 @@ resource "aws_synthetics_canary" "test" {
 `, rName, version))
 }
 
+func testAccCanaryConfig_browserConfig1(rName, browserType1 string) string {
+	return acctest.ConfigCompose(
+		testAccCanaryConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = "syn-nodejs-puppeteer-11.0"
+  delete_lambda        = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  browser_config {
+    browser_type = %[2]q
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, browserType1))
+}
+
+func testAccCanaryConfig_browserConfig2(rName, browserType1, browserType2 string) string {
+	return acctest.ConfigCompose(
+		testAccCanaryConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = "syn-nodejs-puppeteer-11.0"
+  delete_lambda        = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  browser_config {
+    browser_type = %[2]q
+  }
+
+  browser_config {
+    browser_type = %[3]q
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, browserType1, browserType2))
+}
+
 func testAccCanaryConfig_zipUpdated(rName string) string {
 	return acctest.ConfigCompose(
 		testAccCanaryConfig_base(rName),
@@ -0,0 +1,534 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_tax_registration", name="Tax Registration")
+// @Region(global=true)
+func newTaxRegistrationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &taxRegistrationResource{}, nil
+}
+
+type taxRegistrationResource struct {
+	framework.ResourceWithModel[taxRegistrationResourceModel]
+}
+
+func (r *taxRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"certified_email_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"legal_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registration_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 20),
+				},
+			},
+			"registration_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationType](),
+				Required:   true,
+			},
+			"sector": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Sector](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"additional_tax_information": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[additionalTaxInformationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"canada_additional_info": additionalInfoBlock[canadaAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"canada_quebec_sales_tax_number": schema.StringAttribute{
+								Optional: true,
+							},
+							"canada_retail_sales_tax_number": schema.StringAttribute{
+								Optional: true,
+							},
+							"is_reseller_account": schema.BoolAttribute{
+								Optional: true,
+							},
+							"provincial_sales_tax_id": schema.StringAttribute{
+								Optional: true,
+							},
+						}),
+						"estonia_additional_info": additionalInfoBlock[estoniaAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"registry_commercial_code": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"israel_additional_info": additionalInfoBlock[israelAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"customer_type": schema.StringAttribute{
+								Required: true,
+							},
+							"dealer_type": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"italy_additional_info": additionalInfoBlock[italyAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"cig_number": schema.StringAttribute{
+								Optional: true,
+							},
+							"cup_number": schema.StringAttribute{
+								Optional: true,
+							},
+							"sdi": schema.StringAttribute{
+								Optional: true,
+							},
+							"tax_code": schema.StringAttribute{
+								Optional: true,
+							},
+						}),
+						"kenya_additional_info": additionalInfoBlock[kenyaAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"person_type": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"poland_additional_info": additionalInfoBlock[polandAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"individual_registration_number": schema.StringAttribute{
+								Optional: true,
+							},
+							"is_group_vat_enabled": schema.BoolAttribute{
+								Optional: true,
+							},
+						}),
+						"romania_additional_info": additionalInfoBlock[taxRegistrationNumberTypeAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"tax_registration_number_type": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"saudi_arabia_additional_info": additionalInfoBlock[taxRegistrationNumberTypeAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"tax_registration_number_type": schema.StringAttribute{
+								Optional: true,
+							},
+						}),
+						"south_korea_additional_info": additionalInfoBlock[southKoreaAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"business_representative_name": schema.StringAttribute{
+								Required: true,
+							},
+							"item_of_business": schema.StringAttribute{
+								Required: true,
+							},
+							"line_of_business": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"spain_additional_info": additionalInfoBlock[spainAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"registration_type": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+						"turkey_additional_info": additionalInfoBlock[turkeyAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"industries": schema.StringAttribute{
+								Optional: true,
+							},
+							"kep_email_id": schema.StringAttribute{
+								Optional: true,
+							},
+							"secondary_tax_id": schema.StringAttribute{
+								Optional: true,
+							},
+							"tax_office": schema.StringAttribute{
+								Optional: true,
+							},
+						}),
+						"ukraine_additional_info": additionalInfoBlock[ukraineAdditionalInfoModel](ctx, map[string]schema.Attribute{
+							"ukraine_trn_type": schema.StringAttribute{
+								Required: true,
+							},
+						}),
+					},
+				},
+			},
+			"legal_address": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address_line_1": schema.StringAttribute{
+							Required: true,
+						},
+						"address_line_2": schema.StringAttribute{
+							Optional: true,
+						},
+						"address_line_3": schema.StringAttribute{
+							Optional: true,
+						},
+						"city": schema.StringAttribute{
+							Required: true,
+						},
+						"country_code": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(2, 2),
+							},
+						},
+						"district_or_county": schema.StringAttribute{
+							Optional: true,
+						},
+						"postal_code": schema.StringAttribute{
+							Required: true,
+						},
+						"state_or_region": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func additionalInfoBlock[T any](ctx context.Context, attributes map[string]schema.Attribute) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func (r *taxRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data taxRegistrationResourceModel
+	smerr.EnrichAppend(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.AccountID.IsUnknown() {
+		data.AccountID = fwflex.StringValueToFramework(ctx, r.Meta().AccountID(ctx))
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	var entry awstypes.TaxRegistrationEntry
+	smerr.EnrichAppend(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &entry))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := taxsettings.PutTaxRegistrationInput{
+		AccountId:            aws.String(accountID),
+		TaxRegistrationEntry: &entry,
+	}
+
+	_, err := conn.PutTaxRegistration(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	out, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	if data.CertifiedEmailID.IsUnknown() {
+		data.CertifiedEmailID = fwflex.StringToFramework(ctx, out.CertifiedEmailId)
+	}
+	if data.LegalName.IsUnknown() {
+		data.LegalName = fwflex.StringToFramework(ctx, out.LegalName)
+	}
+	data.Sector = fwtypes.StringEnumValue(out.Sector)
+	data.Status = fwtypes.StringEnumValue(out.Status)
+
+	smerr.EnrichAppend(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *taxRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data taxRegistrationResourceModel
+	smerr.EnrichAppend(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	out, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	smerr.EnrichAppend(ctx, &response.Diagnostics, fwflex.Flatten(ctx, out, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.EnrichAppend(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *taxRegistrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state taxRegistrationResourceModel
+	smerr.EnrichAppend(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.EnrichAppend(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, plan.AccountID)
+	var entry awstypes.TaxRegistrationEntry
+	smerr.EnrichAppend(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &entry))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := taxsettings.PutTaxRegistrationInput{
+		AccountId:            aws.String(accountID),
+		TaxRegistrationEntry: &entry,
+	}
+
+	_, err := conn.PutTaxRegistration(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	out, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+
+	if plan.CertifiedEmailID.IsUnknown() {
+		plan.CertifiedEmailID = fwflex.StringToFramework(ctx, out.CertifiedEmailId)
+	}
+	if plan.LegalName.IsUnknown() {
+		plan.LegalName = fwflex.StringToFramework(ctx, out.LegalName)
+	}
+	plan.Sector = fwtypes.StringEnumValue(out.Sector)
+	plan.Status = fwtypes.StringEnumValue(out.Status)
+
+	smerr.EnrichAppend(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *taxRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data taxRegistrationResourceModel
+	smerr.EnrichAppend(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, data.AccountID)
+	input := taxsettings.DeleteTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+
+	_, err := conn.DeleteTaxRegistration(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, accountID)
+		return
+	}
+}
+
+func (r *taxRegistrationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrAccountID), request, response)
+}
+
+func findTaxRegistrationByAccountID(ctx context.Context, conn *taxsettings.Client, accountID string) (*awstypes.TaxRegistration, error) {
+	input := taxsettings.GetTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+
+	out, err := conn.GetTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if out == nil || out.TaxRegistration == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError(&input))
+	}
+
+	if status := out.TaxRegistration.Status; status == awstypes.TaxRegistrationStatusDeleted {
+		return nil, smarterr.NewError(&sdkretry.NotFoundError{
+			Message:     string(status),
+			LastRequest: &input,
+		})
+	}
+
+	return out.TaxRegistration, nil
+}
+
+type taxRegistrationResourceModel struct {
+	AccountID                types.String                                                   `tfsdk:"account_id"`
+	AdditionalTaxInformation fwtypes.ListNestedObjectValueOf[additionalTaxInformationModel] `tfsdk:"additional_tax_information"`
+	CertifiedEmailID         types.String                                                   `tfsdk:"certified_email_id"`
+	LegalAddress             fwtypes.ListNestedObjectValueOf[addressModel]                  `tfsdk:"legal_address"`
+	LegalName                types.String                                                   `tfsdk:"legal_name"`
+	RegistrationID           types.String                                                   `tfsdk:"registration_id"`
+	RegistrationType         fwtypes.StringEnum[awstypes.TaxRegistrationType]               `tfsdk:"registration_type"`
+	Sector                   fwtypes.StringEnum[awstypes.Sector]                            `tfsdk:"sector"`
+	Status                   fwtypes.StringEnum[awstypes.TaxRegistrationStatus]             `tfsdk:"status"`
+}
+
+type addressModel struct {
+	AddressLine1     types.String `tfsdk:"address_line_1"`
+	AddressLine2     types.String `tfsdk:"address_line_2"`
+	AddressLine3     types.String `tfsdk:"address_line_3"`
+	City             types.String `tfsdk:"city"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	DistrictOrCounty types.String `tfsdk:"district_or_county"`
+	PostalCode       types.String `tfsdk:"postal_code"`
+	StateOrRegion    types.String `tfsdk:"state_or_region"`
+}
+
+type additionalTaxInformationModel struct {
+	CanadaAdditionalInfo      fwtypes.ListNestedObjectValueOf[canadaAdditionalInfoModel]                    `tfsdk:"canada_additional_info"`
+	EstoniaAdditionalInfo     fwtypes.ListNestedObjectValueOf[estoniaAdditionalInfoModel]                   `tfsdk:"estonia_additional_info"`
+	IsraelAdditionalInfo      fwtypes.ListNestedObjectValueOf[israelAdditionalInfoModel]                    `tfsdk:"israel_additional_info"`
+	ItalyAdditionalInfo       fwtypes.ListNestedObjectValueOf[italyAdditionalInfoModel]                     `tfsdk:"italy_additional_info"`
+	KenyaAdditionalInfo       fwtypes.ListNestedObjectValueOf[kenyaAdditionalInfoModel]                     `tfsdk:"kenya_additional_info"`
+	PolandAdditionalInfo      fwtypes.ListNestedObjectValueOf[polandAdditionalInfoModel]                    `tfsdk:"poland_additional_info"`
+	RomaniaAdditionalInfo     fwtypes.ListNestedObjectValueOf[taxRegistrationNumberTypeAdditionalInfoModel] `tfsdk:"romania_additional_info"`
+	SaudiArabiaAdditionalInfo fwtypes.ListNestedObjectValueOf[taxRegistrationNumberTypeAdditionalInfoModel] `tfsdk:"saudi_arabia_additional_info"`
+	SouthKoreaAdditionalInfo  fwtypes.ListNestedObjectValueOf[southKoreaAdditionalInfoModel]                `tfsdk:"south_korea_additional_info"`
+	SpainAdditionalInfo       fwtypes.ListNestedObjectValueOf[spainAdditionalInfoModel]                     `tfsdk:"spain_additional_info"`
+	TurkeyAdditionalInfo      fwtypes.ListNestedObjectValueOf[turkeyAdditionalInfoModel]                    `tfsdk:"turkey_additional_info"`
+	UkraineAdditionalInfo     fwtypes.ListNestedObjectValueOf[ukraineAdditionalInfoModel]                   `tfsdk:"ukraine_additional_info"`
+}
+
+type canadaAdditionalInfoModel struct {
+	CanadaQuebecSalesTaxNumber types.String `tfsdk:"canada_quebec_sales_tax_number"`
+	CanadaRetailSalesTaxNumber types.String `tfsdk:"canada_retail_sales_tax_number"`
+	IsResellerAccount          types.Bool   `tfsdk:"is_reseller_account"`
+	ProvincialSalesTaxID       types.String `tfsdk:"provincial_sales_tax_id"`
+}
+
+type estoniaAdditionalInfoModel struct {
+	RegistryCommercialCode types.String `tfsdk:"registry_commercial_code"`
+}
+
+type israelAdditionalInfoModel struct {
+	CustomerType types.String `tfsdk:"customer_type"`
+	DealerType   types.String `tfsdk:"dealer_type"`
+}
+
+type italyAdditionalInfoModel struct {
+	CigNumber types.String `tfsdk:"cig_number"`
+	CupNumber types.String `tfsdk:"cup_number"`
+	Sdi       types.String `tfsdk:"sdi"`
+	TaxCode   types.String `tfsdk:"tax_code"`
+}
+
+type kenyaAdditionalInfoModel struct {
+	PersonType types.String `tfsdk:"person_type"`
+}
+
+type polandAdditionalInfoModel struct {
+	IndividualRegistrationNumber types.String `tfsdk:"individual_registration_number"`
+	IsGroupVatEnabled            types.Bool   `tfsdk:"is_group_vat_enabled"`
+}
+
+type taxRegistrationNumberTypeAdditionalInfoModel struct {
+	TaxRegistrationNumberType types.String `tfsdk:"tax_registration_number_type"`
+}
+
+type southKoreaAdditionalInfoModel struct {
+	BusinessRepresentativeName types.String `tfsdk:"business_representative_name"`
+	ItemOfBusiness             types.String `tfsdk:"item_of_business"`
+	LineOfBusiness             types.String `tfsdk:"line_of_business"`
+}
+
+type spainAdditionalInfoModel struct {
+	RegistrationType types.String `tfsdk:"registration_type"`
+}
+
+type turkeyAdditionalInfoModel struct {
+	Industries     types.String `tfsdk:"industries"`
+	KepEmailID     types.String `tfsdk:"kep_email_id"`
+	SecondaryTaxID types.String `tfsdk:"secondary_tax_id"`
+	TaxOffice      types.String `tfsdk:"tax_office"`
+}
+
+type ukraineAdditionalInfoModel struct {
+	UkraineTrnType types.String `tfsdk:"ukraine_trn_type"`
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Tax registrations are account-wide and validated by AWS, so the test only runs
+// when a known-good registration ID for the test account's country is supplied.
+func TestAccTaxSettingsTaxRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, "AWS_TAXSETTINGS_REGISTRATION_ID")
+	countryCode := acctest.SkipIfEnvVarNotSet(t, "AWS_TAXSETTINGS_COUNTRY_CODE")
+	var v awstypes.TaxRegistration
+	resourceName := "aws_taxsettings_tax_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID, countryCode, "Example Corp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrAccountID), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("legal_name"), knownvalue.StringExact("Example Corp")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("registration_id"), knownvalue.StringExact(registrationID)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("registration_type"), knownvalue.StringExact("VAT")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrStatus), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrAccountID),
+				ImportStateVerifyIdentifierAttribute: names.AttrAccountID,
+			},
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID, countryCode, "Example Corp Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("legal_name"), knownvalue.StringExact("Example Corp Updated")),
+				},
+			},
+			{
+				Config: testAccTaxRegistrationConfig_noLegalName(registrationID, countryCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("legal_name"), knownvalue.StringExact("Example Corp Updated")),
+				},
+			},
+			{
+				Config:   testAccTaxRegistrationConfig_noLegalName(registrationID, countryCode),
+				PlanOnly: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPreRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTaxRegistrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_tax_registration" {
+				continue
+			}
+
+			_, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Tax Settings Tax Registration %s still exists", rs.Primary.Attributes[names.AttrAccountID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTaxRegistrationExists(ctx context.Context, n string, v *awstypes.TaxRegistration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		output, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTaxRegistrationConfig_basic(registrationID, countryCode, legalName string) string {
+	return fmt.Sprintf(`
+resource "aws_taxsettings_tax_registration" "test" {
+  registration_id   = %[1]q
+  registration_type = "VAT"
+  legal_name        = %[3]q
+
+  legal_address {
+    address_line_1 = "1 Example Street"
+    city           = "Example City"
+    country_code   = %[2]q
+    postal_code    = "00000"
+  }
+}
+`, registrationID, countryCode, legalName)
+}
+
+func testAccTaxRegistrationConfig_noLegalName(registrationID, countryCode string) string {
+	return fmt.Sprintf(`
+resource "aws_taxsettings_tax_registration" "test" {
+  registration_id   = %[1]q
+  registration_type = "VAT"
+
+  legal_address {
+    address_line_1 = "1 Example Street"
+    city           = "Example City"
+    country_code   = %[2]q
+    postal_code    = "00000"
+  }
+}
+`, registrationID, countryCode)
+}
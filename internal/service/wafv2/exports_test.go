@@ -14,16 +14,17 @@ var (
 	ResourceAPIKey                     = newAPIKeyResource
 	ResourceWebACLRuleGroupAssociation = newResourceWebACLRuleGroupAssociation
 
-	CloudFrontDistributionIDFromARN   = cloudFrontDistributionIDFromARN
-	FindAPIKeyByTwoPartKey            = findAPIKeyByTwoPartKey
-	FindIPSetByThreePartKey           = findIPSetByThreePartKey
-	FindLoggingConfigurationByARN     = findLoggingConfigurationByARN
-	FindRegexPatternSetByThreePartKey = findRegexPatternSetByThreePartKey
-	FindRuleGroupByThreePartKey       = findRuleGroupByThreePartKey
-	FindWebACLByResourceARN           = findWebACLByResourceARN
-	FindWebACLByThreePartKey          = findWebACLByThreePartKey
-	IsCloudFrontDistributionARN       = isCloudFrontDistributionARN
-	ListRuleGroupsPages               = listRuleGroupsPages
-	ListWebACLsPages                  = listWebACLsPages
-	ParseWebACLARN                    = parseWebACLARN
+	CloudFrontDistributionIDFromARN      = cloudFrontDistributionIDFromARN
+	FindAPIKeyByTwoPartKey               = findAPIKeyByTwoPartKey
+	FindIPSetByThreePartKey              = findIPSetByThreePartKey
+	FindLoggingConfigurationByARN        = findLoggingConfigurationByARN
+	FindLoggingConfigurationByTwoPartKey = findLoggingConfigurationByTwoPartKey
+	FindRegexPatternSetByThreePartKey    = findRegexPatternSetByThreePartKey
+	FindRuleGroupByThreePartKey          = findRuleGroupByThreePartKey
+	FindWebACLByResourceARN              = findWebACLByResourceARN
+	FindWebACLByThreePartKey             = findWebACLByThreePartKey
+	IsCloudFrontDistributionARN          = isCloudFrontDistributionARN
+	ListRuleGroupsPages                  = listRuleGroupsPages
+	ListWebACLsPages                     = listWebACLsPages
+	ParseWebACLARN                       = parseWebACLARN
 )
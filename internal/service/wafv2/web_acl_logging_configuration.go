@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -37,7 +39,7 @@ func resourceWebACLLoggingConfiguration() *schema.Resource {
 		DeleteWithoutTimeout: resourceWebACLLoggingConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceWebACLLoggingConfigurationImport,
 		},
 
 		SchemaFunc: func() map[string]*schema.Schema {
@@ -54,6 +56,14 @@ func resourceWebACLLoggingConfiguration() *schema.Resource {
 					},
 					Description: "AWS Kinesis Firehose Delivery Stream ARNs",
 				},
+				"log_scope": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.LogScope](),
+					Description:      "Owner of the logging configuration",
+				},
 				"logging_filter": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -185,6 +195,11 @@ func resourceWebACLLoggingConfigurationPut(ctx context.Context, d *schema.Resour
 		ResourceArn:           aws.String(resourceARN),
 	}
 
+	if v, ok := d.GetOk("log_scope"); ok {
+		config.LogScope = awstypes.LogScope(v.(string))
+		config.LogType = awstypes.LogTypeWafLogs
+	}
+
 	if v, ok := d.GetOk("logging_filter"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.LoggingFilter = expandLoggingFilter(v.([]any))
 	}
@@ -216,7 +231,7 @@ func resourceWebACLLoggingConfigurationRead(ctx context.Context, d *schema.Resou
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
 
-	loggingConfig, err := findLoggingConfigurationByARN(ctx, conn, d.Id())
+	loggingConfig, err := findLoggingConfigurationByTwoPartKey(ctx, conn, d.Id(), awstypes.LogScope(d.Get("log_scope").(string)))
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] WAFv2 WebACL Logging Configuration (%s) not found, removing from state", d.Id())
@@ -237,6 +252,7 @@ func resourceWebACLLoggingConfigurationRead(ctx context.Context, d *schema.Resou
 	if err := d.Set("redacted_fields", flattenRedactedFields(loggingConfig.RedactedFields)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting redacted_fields: %s", err)
 	}
+	d.Set("log_scope", loggingConfig.LogScope)
 	d.Set(names.AttrResourceARN, loggingConfig.ResourceArn)
 
 	return diags
@@ -250,6 +266,10 @@ func resourceWebACLLoggingConfigurationDelete(ctx context.Context, d *schema.Res
 	input := wafv2.DeleteLoggingConfigurationInput{
 		ResourceArn: aws.String(d.Id()),
 	}
+	if v, ok := d.GetOk("log_scope"); ok {
+		input.LogScope = awstypes.LogScope(v.(string))
+		input.LogType = awstypes.LogTypeWafLogs
+	}
 	_, err := conn.DeleteLoggingConfiguration(ctx, &input)
 
 	if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
@@ -263,10 +283,38 @@ func resourceWebACLLoggingConfigurationDelete(ctx context.Context, d *schema.Res
 	return diags
 }
 
+const webACLLoggingConfigurationImportIDSeparator = ","
+
+// The import ID is the web ACL ARN, optionally followed by the log scope, e.g. "<web-acl-arn>,SECURITY_LAKE".
+func resourceWebACLLoggingConfigurationImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	resourceARN, logScope, found := strings.Cut(d.Id(), webACLLoggingConfigurationImportIDSeparator)
+
+	if !found {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if resourceARN == "" || !slices.Contains(enum.Values[awstypes.LogScope](), logScope) {
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected WEB-ACL-ARN or WEB-ACL-ARN%[2]sLOG-SCOPE", d.Id(), webACLLoggingConfigurationImportIDSeparator)
+	}
+
+	d.SetId(resourceARN)
+	d.Set("log_scope", logScope)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findLoggingConfigurationByARN(ctx context.Context, conn *wafv2.Client, arn string) (*awstypes.LoggingConfiguration, error) {
+	return findLoggingConfigurationByTwoPartKey(ctx, conn, arn, "")
+}
+
+func findLoggingConfigurationByTwoPartKey(ctx context.Context, conn *wafv2.Client, arn string, logScope awstypes.LogScope) (*awstypes.LoggingConfiguration, error) {
 	input := &wafv2.GetLoggingConfigurationInput{
 		ResourceArn: aws.String(arn),
 	}
+	if logScope != "" {
+		input.LogScope = logScope
+		input.LogType = awstypes.LogTypeWafLogs
+	}
 
 	output, err := conn.GetLoggingConfiguration(ctx, input)
 
@@ -0,0 +1,595 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspaces_pool", name="Pool")
+// @Tags(identifierAttribute="id")
+func newPoolResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &poolResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type poolResource struct {
+	framework.ResourceWithModel[poolResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *poolResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_settings": schema.ListAttribute{ // proto5 Optional+Computed nested block.
+				CustomType: fwtypes.NewListNestedObjectTypeOf[applicationSettingsModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[applicationSettingsModel](ctx),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bundle_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"directory_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"running_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PoolsRunningMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkspacesPoolState](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"timeout_settings": schema.ListAttribute{ // proto5 Optional+Computed nested block.
+				CustomType: fwtypes.NewListNestedObjectTypeOf[timeoutSettingsModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[timeoutSettingsModel](ctx),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"capacity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"desired_user_sessions": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *poolResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	name := data.PoolName.ValueString()
+	var input workspaces.CreateWorkspacesPoolInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkspacesPool(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Pool (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	id := aws.ToString(output.WorkspacesPool.PoolId)
+	data.PoolID = fwflex.StringValueToFramework(ctx, id)
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	pool, err := waitPoolCreated(ctx, conn, id, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) create", id), err.Error())
+
+		return
+	}
+
+	// New pools are created in the STOPPED state.
+	if pool.State == awstypes.WorkspacesPoolStateStopped {
+		pool, err = startPool(ctx, conn, id, timeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("starting WorkSpaces Pool (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(flattenPool(ctx, pool, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *poolResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	pool, err := findPoolByID(ctx, conn, data.PoolID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Pool (%s)", data.PoolID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenPool(ctx, pool, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *poolResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	id := new.PoolID.ValueString()
+
+	if !new.ApplicationSettings.Equal(old.ApplicationSettings) ||
+		!new.BundleID.Equal(old.BundleID) ||
+		!new.Capacity.Equal(old.Capacity) ||
+		!new.Description.Equal(old.Description) ||
+		!new.DirectoryID.Equal(old.DirectoryID) ||
+		!new.RunningMode.Equal(old.RunningMode) ||
+		!new.TimeoutSettings.Equal(old.TimeoutSettings) {
+		var input workspaces.UpdateWorkspacesPoolInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWorkspacesPool(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Pool (%s)", id), err.Error())
+
+			return
+		}
+
+		pool, err := waitPoolUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) update", id), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(flattenPool(ctx, pool, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.State = old.State
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *poolResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	id := data.PoolID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A pool must be stopped before it can be terminated.
+	pool, err := findPoolByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Pool (%s)", id), err.Error())
+
+		return
+	}
+
+	if pool.State != awstypes.WorkspacesPoolStateStopped {
+		if err := stopPool(ctx, conn, id, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping WorkSpaces Pool (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	input := workspaces.TerminateWorkspacesPoolInput{
+		PoolId: aws.String(id),
+	}
+	_, err = conn.TerminateWorkspacesPool(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Pool (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitPoolDeleted(ctx, conn, id, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func flattenPool(ctx context.Context, pool *awstypes.WorkspacesPool, data *poolResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, pool, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API returns the capacity status rather than the requested capacity.
+	if v := pool.CapacityStatus; v != nil {
+		data.Capacity = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &capacityModel{
+			DesiredUserSessions: fwflex.Int32ToFramework(ctx, v.DesiredUserSessions),
+		})
+	}
+
+	return diags
+}
+
+func startPool(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	input := workspaces.StartWorkspacesPoolInput{
+		PoolId: aws.String(id),
+	}
+
+	if _, err := conn.StartWorkspacesPool(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	return waitPoolRunning(ctx, conn, id, timeout)
+}
+
+func stopPool(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) error {
+	input := workspaces.StopWorkspacesPoolInput{
+		PoolId: aws.String(id),
+	}
+
+	if _, err := conn.StopWorkspacesPool(ctx, &input); err != nil {
+		return err
+	}
+
+	_, err := waitPoolStopped(ctx, conn, id, timeout)
+
+	return err
+}
+
+func findPoolByID(ctx context.Context, conn *workspaces.Client, id string) (*awstypes.WorkspacesPool, error) {
+	input := workspaces.DescribeWorkspacesPoolsInput{
+		PoolIds: []string{id},
+	}
+
+	return findPool(ctx, conn, &input)
+}
+
+func findPool(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeWorkspacesPoolsInput) (*awstypes.WorkspacesPool, error) {
+	output, err := findPools(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPools(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeWorkspacesPoolsInput) ([]awstypes.WorkspacesPool, error) {
+	var output []awstypes.WorkspacesPool
+
+	for {
+		page, err := conn.DescribeWorkspacesPools(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.WorkspacesPools...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func statusPool(ctx context.Context, conn *workspaces.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findPoolByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitPoolCreated(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateCreating),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateStopped, awstypes.WorkspacesPoolStateRunning),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolRunning(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateStopped, awstypes.WorkspacesPoolStateStarting),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateRunning),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolUpdated(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateUpdating),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateRunning, awstypes.WorkspacesPoolStateStopped),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolStopped(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateRunning, awstypes.WorkspacesPoolStateStopping),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateStopped),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolDeleted(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateStopped, awstypes.WorkspacesPoolStateDeleting),
+		Target:  []string{},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func poolError(apiObjects []awstypes.WorkspacesPoolError) error {
+	return errors.Join(tfslices.ApplyToAll(apiObjects, func(v awstypes.WorkspacesPoolError) error {
+		return fmt.Errorf("%s: %s", v.ErrorCode, aws.ToString(v.ErrorMessage))
+	})...)
+}
+
+type poolResourceModel struct {
+	framework.WithRegionModel
+	ApplicationSettings fwtypes.ListNestedObjectValueOf[applicationSettingsModel] `tfsdk:"application_settings"`
+	BundleID            types.String                                              `tfsdk:"bundle_id"`
+	Capacity            fwtypes.ListNestedObjectValueOf[capacityModel]            `tfsdk:"capacity"`
+	Description         types.String                                              `tfsdk:"description"`
+	DirectoryID         types.String                                              `tfsdk:"directory_id"`
+	PoolARN             types.String                                              `tfsdk:"arn"`
+	PoolID              types.String                                              `tfsdk:"id"`
+	PoolName            types.String                                              `tfsdk:"name"`
+	RunningMode         fwtypes.StringEnum[awstypes.PoolsRunningMode]             `tfsdk:"running_mode"`
+	State               fwtypes.StringEnum[awstypes.WorkspacesPoolState]          `tfsdk:"state"`
+	Tags                tftags.Map                                                `tfsdk:"tags"`
+	TagsAll             tftags.Map                                                `tfsdk:"tags_all"`
+	TimeoutSettings     fwtypes.ListNestedObjectValueOf[timeoutSettingsModel]     `tfsdk:"timeout_settings"`
+	Timeouts            timeouts.Value                                            `tfsdk:"timeouts"`
+}
+
+type applicationSettingsModel struct {
+	S3BucketName  types.String                                               `tfsdk:"s3_bucket_name"`
+	SettingsGroup types.String                                               `tfsdk:"settings_group"`
+	Status        fwtypes.StringEnum[awstypes.ApplicationSettingsStatusEnum] `tfsdk:"status"`
+}
+
+type capacityModel struct {
+	DesiredUserSessions types.Int32 `tfsdk:"desired_user_sessions"`
+}
+
+type timeoutSettingsModel struct {
+	DisconnectTimeoutInSeconds     types.Int32 `tfsdk:"disconnect_timeout_in_seconds"`
+	IdleDisconnectTimeoutInSeconds types.Int32 `tfsdk:"idle_disconnect_timeout_in_seconds"`
+	MaxUserDurationInSeconds       types.Int32 `tfsdk:"max_user_duration_in_seconds"`
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspaces "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPool_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkspacesPool
+	rName := sdkacctest.RandString(8)
+	bundleID := acctest.SkipIfEnvVarNotSet(t, "WORKSPACES_POOL_BUNDLE_ID")
+	resourceName := "aws_workspaces_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(rName, bundleID, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "bundle_id", bundleID),
+					resource.TestCheckResourceAttr(resourceName, "capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.desired_user_sessions", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_workspaces_directory.pool", "directory_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.WorkspacesPoolStateRunning)),
+					resource.TestCheckResourceAttr(resourceName, "timeout_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_settings.0.disconnect_timeout_in_seconds", "900"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPoolConfig_basic(rName, bundleID, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.desired_user_sessions", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPool_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkspacesPool
+	rName := sdkacctest.RandString(8)
+	bundleID := acctest.SkipIfEnvVarNotSet(t, "WORKSPACES_POOL_BUNDLE_ID")
+	resourceName := "aws_workspaces_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(rName, bundleID, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspaces.ResourcePool, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPoolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspaces_pool" {
+				continue
+			}
+
+			_, err := tfworkspaces.FindPoolByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Pool %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPoolExists(ctx context.Context, n string, v *awstypes.WorkspacesPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		output, err := tfworkspaces.FindPoolByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPoolConfig_basic(rName, bundleID string, desiredUserSessions int) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_poolsBasic(rName),
+		fmt.Sprintf(`
+resource "aws_workspaces_pool" "test" {
+  name         = %[1]q
+  description  = %[1]q
+  bundle_id    = %[2]q
+  directory_id = aws_workspaces_directory.pool.directory_id
+
+  capacity {
+    desired_user_sessions = %[3]d
+  }
+
+  timeout_settings {
+    disconnect_timeout_in_seconds      = 900
+    idle_disconnect_timeout_in_seconds = 900
+    max_user_duration_in_seconds       = 3600
+  }
+}
+`, rName, bundleID, desiredUserSessions))
+}
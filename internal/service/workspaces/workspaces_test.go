@@ -36,6 +36,10 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			"multipleDirectories": testAccIPGroup_MultipleDirectories,
 			"tags":                testAccIPGroup_tags,
 		},
+		"Pool": {
+			acctest.CtBasic:      testAccPool_basic,
+			acctest.CtDisappears: testAccPool_disappears,
+		},
 		"Workspace": {
 			acctest.CtBasic:          testAccWorkspace_basic,
 			"recreate":               testAccWorkspace_recreate,
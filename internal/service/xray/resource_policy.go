@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -50,6 +52,9 @@ func (r *resourcePolicyResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"policy_name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"bypass_policy_lockout_check": schema.BoolAttribute{
 				Optional: true,
@@ -137,6 +142,55 @@ func (r *resourcePolicyResource) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *resourcePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().XRayClient(ctx)
+
+	var plan, state resourcePolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PolicyDocument.Equal(state.PolicyDocument) || !plan.BypassPolicyLockoutCheck.Equal(state.BypassPolicyLockoutCheck) {
+		in := xray.PutResourcePolicyInput{
+			PolicyDocument: plan.PolicyDocument.ValueStringPointer(),
+			PolicyName:     plan.PolicyName.ValueStringPointer(),
+		}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Guard against concurrent modification by supplying the revision last read.
+		in.PolicyRevisionId = state.PolicyRevisionID.ValueStringPointer()
+
+		out, err := conn.PutResourcePolicy(ctx, &in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.XRay, create.ErrActionUpdating, ResNameResourcePolicy, plan.PolicyName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil || out.ResourcePolicy == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.XRay, create.ErrActionUpdating, ResNameResourcePolicy, plan.PolicyName.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+
+		plan.LastUpdatedTime = fwflex.TimeToFramework(ctx, out.ResourcePolicy.LastUpdatedTime)
+		plan.PolicyRevisionID = fwflex.StringToFramework(ctx, out.ResourcePolicy.PolicyRevisionId)
+	} else {
+		plan.LastUpdatedTime = state.LastUpdatedTime
+		plan.PolicyRevisionID = state.PolicyRevisionID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
 func (r *resourcePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	conn := r.Meta().XRayClient(ctx)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/xray/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -54,6 +55,53 @@ func TestAccXRayResourcePolicy_basic(t *testing.T) {
 					"bypass_policy_lockout_check",
 				},
 			},
+			{
+				Config: testAccResourcePolicyConfig_equivalentDocument(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccXRayResourcePolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourcepolicy types.ResourcePolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_xray_resource_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.XRayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(ctx, resourceName, &resourcepolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_revision_id", "1"),
+				),
+			},
+			{
+				Config: testAccResourcePolicyConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(ctx, resourceName, &resourcepolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_revision_id", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "policy_document", `{"Version":"2012-10-17","Statement":[{"Sid":"AllowXRayPutTraceSegments","Effect":"Allow","Principal":{"AWS":"*"},"Action":"xray:PutTraceSegments","Resource":"*"}]}`),
+				),
+			},
 		},
 	})
 }
@@ -156,3 +204,41 @@ resource "aws_xray_resource_policy" "test" {
 }
 `, rName)
 }
+
+func testAccResourcePolicyConfig_equivalentDocument(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_resource_policy" "test" {
+  policy_name = %[1]q
+  policy_document = jsonencode({
+    Statement = [{
+      Sid       = "AllowXRayAccess"
+      Effect    = "Allow"
+      Principal = { AWS = "*" }
+      Action    = ["xray:*", "xray:PutResourcePolicy"]
+      Resource  = "*"
+    }]
+    Version = "2012-10-17"
+  })
+  bypass_policy_lockout_check = true
+}
+`, rName)
+}
+
+func testAccResourcePolicyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_resource_policy" "test" {
+  policy_name = %[1]q
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowXRayPutTraceSegments"
+      Effect    = "Allow"
+      Principal = { AWS = "*" }
+      Action    = "xray:PutTraceSegments"
+      Resource  = "*"
+    }]
+  })
+  bypass_policy_lockout_check = true
+}
+`, rName)
+}
@@ -106,7 +106,7 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 
 // IgnoreAWS returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAWS() KeyValueTags { // nosemgrep:ci.aws-in-func-name
-	result := make(KeyValueTags)
+	result := make(KeyValueTags, len(tags))
 
 	for k, v := range tags {
 		if !strings.HasPrefix(k, awsTagKeyPrefix) {
@@ -123,15 +123,27 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 		return tags
 	}
 
-	result := tags.IgnorePrefixes(config.KeyPrefixes)
-	result = result.Ignore(config.Keys)
+	// Filter keys and key prefixes in a single pass so that only one map is allocated.
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		if _, ok := config.Keys[k]; ok {
+			continue
+		}
+
+		if hasAnyPrefix(k, config.KeyPrefixes) {
+			continue
+		}
+
+		result[k] = v
+	}
 
 	return result
 }
 
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
-	result := make(KeyValueTags)
+	result := make(KeyValueTags, len(tags))
 
 	for k, v := range tags {
 		if strings.HasPrefix(k, awsTagKeyPrefix) {
@@ -154,19 +166,10 @@ func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 
 // IgnorePrefixes returns non-matching tag key prefixes.
 func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
-	result := make(KeyValueTags)
+	result := make(KeyValueTags, len(tags))
 
 	for k, v := range tags {
-		var ignore bool
-
-		for ignoreTagPrefix := range ignoreTagPrefixes {
-			if strings.HasPrefix(k, ignoreTagPrefix) {
-				ignore = true
-				break
-			}
-		}
-
-		if ignore {
+		if hasAnyPrefix(k, ignoreTagPrefixes) {
 			continue
 		}
 
@@ -176,9 +179,20 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// hasAnyPrefix returns whether the key starts with any of the given tag key prefixes.
+func hasAnyPrefix(key string, prefixes KeyValueTags) bool {
+	for prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
-	result := make(KeyValueTags)
+	result := make(KeyValueTags, len(tags))
 
 	for k, v := range tags {
 		if strings.HasPrefix(k, awsTagKeyPrefix) {
@@ -210,7 +224,7 @@ func (tags KeyValueTags) IgnoreSystem(serviceName string) KeyValueTags {
 
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
-	result := make(KeyValueTags)
+	result := make(KeyValueTags, len(tags))
 
 	for k, v := range tags {
 		if _, ok := ignoreTags[k]; ok {
@@ -318,7 +332,7 @@ func (tags KeyValueTags) Map() map[string]string {
 
 // Merge adds missing and updates existing tags.
 func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
-	result := make(KeyValueTags)
+	result := make(KeyValueTags, len(tags)+len(mergeTags))
 
 	maps.Copy(result, tags)
 
@@ -480,7 +494,7 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 		return tags
 	}
 
-	result := make(KeyValueTags)
+	result := make(KeyValueTags, len(tags))
 
 	for k, v := range tags {
 		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
@@ -6,6 +6,7 @@ package tags
 import (
 	"context"
 	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -578,6 +579,42 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func BenchmarkKeyValueTagsIgnoreConfig(b *testing.B) {
+	ctx := context.Background()
+	tags := New(ctx, testKeyValueTagsBenchmarkMap("key", 50))
+	ignoreConfig := &IgnoreConfig{
+		Keys:        New(ctx, []any{"key1", "key2", "key3"}),
+		KeyPrefixes: New(ctx, []any{"key4", "ignored"}),
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if got := tags.IgnoreConfig(ignoreConfig); len(got) == 0 {
+			b.Fatal("unexpected empty result")
+		}
+	}
+}
+
+// BenchmarkKeyValueTagsTagsAll measures the tags_all computation performed for every tagged resource.
+func BenchmarkKeyValueTagsTagsAll(b *testing.B) {
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, testKeyValueTagsBenchmarkMap("default", 25)),
+	}
+	ignoreConfig := &IgnoreConfig{
+		Keys:        New(ctx, []any{"default1"}),
+		KeyPrefixes: New(ctx, []any{"ignored"}),
+	}
+	tags := New(ctx, testKeyValueTagsBenchmarkMap("resource", 25))
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if got := defaultConfig.MergeTags(tags).IgnoreConfig(ignoreConfig).Map(); len(got) == 0 {
+			b.Fatal("unexpected empty result")
+		}
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	t.Parallel()
 
@@ -1533,6 +1570,19 @@ func TestKeyValueTagsMerge(t *testing.T) {
 	}
 }
 
+func BenchmarkKeyValueTagsMerge(b *testing.B) {
+	ctx := context.Background()
+	tags := New(ctx, testKeyValueTagsBenchmarkMap("default", 25))
+	mergeTags := New(ctx, testKeyValueTagsBenchmarkMap("resource", 25))
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if got := tags.Merge(mergeTags); len(got) != 50 {
+			b.Fatalf("unexpected length: %d", len(got))
+		}
+	}
+}
+
 func TestKeyValueTagsOnly(t *testing.T) {
 	t.Parallel()
 
@@ -2849,3 +2899,13 @@ func testBoolPtr(b bool) *bool {
 func testStringPtr(str string) *string {
 	return &str
 }
+
+func testKeyValueTagsBenchmarkMap(keyPrefix string, n int) map[string]string {
+	m := make(map[string]string, n)
+
+	for i := range n {
+		m[keyPrefix+strconv.Itoa(i)] = "value" + strconv.Itoa(i)
+	}
+
+	return m
+}
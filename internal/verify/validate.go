@@ -141,6 +141,32 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 	}
 }
 
+// ValidARNForService validates that a string value matches an ARN format (see ValidARNCheck)
+// for the specified service and, if any are supplied, one of the specified resource types.
+// A resource type matches a resource part of the form "<type>/...".
+func ValidARNForService(service string, resourceTypes ...string) schema.SchemaValidateFunc {
+	return ValidARNCheck(func(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+		if arn.Service != service {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected service %q, got %q", k, v, service, arn.Service))
+			return ws, errors
+		}
+
+		if len(resourceTypes) > 0 && !slices.ContainsFunc(resourceTypes, func(resourceType string) bool {
+			return strings.HasPrefix(arn.Resource, resourceType+"/")
+		}) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected resource type to be one of %q", k, v, resourceTypes))
+		}
+
+		return ws, errors
+	})
+}
+
+// ValidIAMRoleARN validates that a string value is an IAM role ARN.
+var ValidIAMRoleARN = ValidARNForService("iam", "role")
+
+// ValidKMSKeyARN validates that a string value is a KMS key or alias ARN.
+var ValidKMSKeyARN = ValidARNForService("kms", "key", "alias")
+
 func ValidAccountID(v any, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -200,6 +200,52 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNForService(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		f           schema.SchemaValidateFunc
+		value       string
+		expectedErr string
+	}{
+		{ValidIAMRoleARN, "", ""},
+		{ValidIAMRoleARN, "arn:aws:iam::123456789012:role/MyRole", ""},                                 // lintignore:AWSAT005
+		{ValidIAMRoleARN, "arn:aws:iam::123456789012:role/service-role/MyRole", ""},                    // lintignore:AWSAT005
+		{ValidIAMRoleARN, "arn:aws-us-gov:iam::123456789012:role/MyRole", ""},                          // lintignore:AWSAT005
+		{ValidIAMRoleARN, "arn:aws:iam::123456789012:user/David", "expected resource type"},            // lintignore:AWSAT005
+		{ValidIAMRoleARN, "arn:aws:sts::123456789012:assumed-role/MyRole/session", "expected service"}, // lintignore:AWSAT005
+		{ValidIAMRoleARN, "arn:aws:iam::12345:role/MyRole", "invalid account ID value"},                // lintignore:AWSAT005
+		{ValidIAMRoleARN, "MyRole", "is an invalid ARN"},
+		{ValidKMSKeyARN, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", ""}, // lintignore:AWSAT003,AWSAT005
+		{ValidKMSKeyARN, "arn:aws:kms:us-west-2:123456789012:alias/my-key", ""},                             // lintignore:AWSAT003,AWSAT005
+		{ValidKMSKeyARN, "arn:aws:kms:us-west-2:123456789012:grant/1234abcd", "expected resource type"},     // lintignore:AWSAT003,AWSAT005
+		{ValidKMSKeyARN, "arn:aws:kms:not-a-region:123456789012:key/1234abcd", "invalid region value"},      // lintignore:AWSAT005
+		{ValidKMSKeyARN, "1234abcd-12ab-34cd-56ef-1234567890ab", "is an invalid ARN"},
+		{ValidARNForService("sqs"), "arn:aws:sqs:us-west-2:123456789012:my-queue", ""},                 // lintignore:AWSAT003,AWSAT005
+		{ValidARNForService("sqs"), "arn:aws:sns:us-west-2:123456789012:my-topic", "expected service"}, // lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, tc := range cases {
+		_, errors := tc.f(tc.value, "arn")
+
+		if tc.expectedErr == "" {
+			if len(errors) != 0 {
+				t.Errorf("%q should be valid: %q", tc.value, errors)
+			}
+			continue
+		}
+
+		if len(errors) == 0 {
+			t.Errorf("%q should be invalid", tc.value)
+			continue
+		}
+
+		if !strings.Contains(errors[0].Error(), tc.expectedErr) {
+			t.Errorf("%q: expected error containing %q, got %q", tc.value, tc.expectedErr, errors[0])
+		}
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 